@@ -0,0 +1,129 @@
+package di
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestAliasChain kiểm tra chuỗi alias được resolve bắc cầu
+func TestAliasChain(t *testing.T) {
+	container := New()
+
+	container.Singleton("database.mysql", func(c Container) interface{} { return NewMockService("mysql") })
+	container.Alias("database.mysql", "database")
+	container.Alias("database", "db")
+
+	db, err := container.Make("db")
+	if err != nil {
+		t.Fatalf("Make() qua chuỗi alias lỗi: %v", err)
+	}
+	if db != container.MustMake("database.mysql") {
+		t.Error("Chuỗi alias nên resolve về cùng singleton")
+	}
+
+	if !container.Bound("db") {
+		t.Error("Bound() nên theo chuỗi alias")
+	}
+	if container.BindIf("db", func(c Container) interface{} { return "other" }) {
+		t.Error("BindIf() qua chuỗi alias không nên override binding của key gốc")
+	}
+
+	// Alias trỏ tới key chưa đăng ký không được coi là đã đăng ký
+	container.Alias("cache.redis", "cache")
+	if container.Bound("cache") {
+		t.Error("Bound() với alias trỏ tới key chưa đăng ký nên trả về false")
+	}
+	if !container.BindIf("cache", func(c Container) interface{} { return "redis" }) {
+		t.Fatal("BindIf() qua alias nên đăng ký binding cho key gốc")
+	}
+	if value := container.MustMake("cache.redis"); value != "redis" {
+		t.Errorf("BindIf() qua alias nên đăng ký cho key gốc, got: %v", value)
+	}
+}
+
+// TestAliasRegister kiểm tra đăng ký qua alias đăng ký cho key gốc
+func TestAliasRegister(t *testing.T) {
+	container := New()
+
+	container.Singleton("database", func(c Container) interface{} { return NewMockService("v1") })
+	container.Alias("database", "db")
+
+	container.Bind("db", func(c Container) interface{} { return NewMockService("v2") })
+	if value := container.MustMake("db").(*MockService); value.ID != "v2" {
+		t.Errorf("Bind() qua alias nên thay binding của key gốc, got: %v", value.ID)
+	}
+	if value := container.MustMake("database").(*MockService); value.ID != "v2" {
+		t.Errorf("Key gốc nên dùng binding đăng ký qua alias, got: %v", value.ID)
+	}
+
+	container.Instance("db", "instance")
+	if value := container.MustMake("database"); value != "instance" {
+		t.Errorf("Instance() qua alias nên lưu cho key gốc, got: %v", value)
+	}
+
+	kinds := make(map[string]BindingKind)
+	for _, info := range container.Describe() {
+		kinds[info.Key] = info.Kind
+	}
+	if len(kinds) != 2 || kinds["db"] != KindAlias || kinds["database"] != KindInstance {
+		t.Errorf("Describe không nên liệt kê binding dưới tên alias, got: %v", kinds)
+	}
+}
+
+// TestAliasChainChild kiểm tra chuỗi alias đi qua container cha và container con
+func TestAliasChainChild(t *testing.T) {
+	parent := New()
+	parent.Instance("logger.file", "file")
+	parent.Alias("logger.file", "logger")
+
+	child := parent.NewChild()
+	child.Instance("logger.stdout", "stdout")
+	child.Alias("logger", "log")
+
+	if value := child.MustMake("log"); value != "file" {
+		t.Errorf("Chuỗi alias nên đi qua container cha, got: %v", value)
+	}
+
+	// Alias của container con được ưu tiên ở mỗi bước
+	child.Alias("logger.stdout", "logger")
+	if value := child.MustMake("log"); value != "stdout" {
+		t.Errorf("Alias của container con nên được ưu tiên, got: %v", value)
+	}
+	if value := parent.MustMake("logger"); value != "file" {
+		t.Errorf("Alias của container con không nên ảnh hưởng container cha, got: %v", value)
+	}
+}
+
+// TestAliasCycle kiểm tra alias trỏ tới chính nó hoặc khép vòng bị từ chối
+func TestAliasCycle(t *testing.T) {
+	container := New()
+	container.Instance("service", "value")
+
+	expectAliasError := func(abstract, alias, message string) {
+		t.Helper()
+		defer func() {
+			recovered := recover()
+			err, ok := recovered.(error)
+			var aliasErr *AliasError
+			if !ok || !errors.Is(err, ErrAliasCycle) || !errors.As(err, &aliasErr) {
+				t.Fatalf("Alias(%q, %q) nên panic với *AliasError, got: %v", abstract, alias, recovered)
+			}
+			if err.Error() != message {
+				t.Errorf("Alias(%q, %q) message = %q, expected %q", abstract, alias, err.Error(), message)
+			}
+		}()
+		container.Alias(abstract, alias)
+	}
+
+	expectAliasError("service", "service", "service is aliased to itself")
+
+	container.Alias("service", "a")
+	container.Alias("a", "b")
+	expectAliasError("b", "a", "alias cycle: a -> b -> a")
+	expectAliasError("b", "service", "alias cycle: service -> b -> a -> service")
+
+	// Alias bị từ chối không được lưu
+	if value := container.MustMake("b"); value != "value" {
+		t.Errorf("Alias bị từ chối không nên thay đổi chuỗi alias, got: %v", value)
+	}
+}
@@ -1,5 +1,81 @@
 package di
 
+import "context"
+
 // BindingFunc là một hàm trả về một instance của dependency.
 // BindingFunc được sử dụng để đăng ký các dependency trong container.
 type BindingFunc func(c Container) interface{}
+
+// BindingFuncE là factory function có thể trả về lỗi.
+//
+// BindingFuncE được đăng ký qua BindE, BindIfE, SingletonE. Lỗi trả về được Make truyền lại cho caller
+// (bọc trong *ResolutionError), và singleton lỗi không được cache.
+type BindingFuncE func(c Container) (interface{}, error)
+
+// binding chuyển BindingFuncE thành BindingFunc, lỗi được bọc trong bindingFailure để container nhận diện.
+func (f BindingFuncE) binding() BindingFunc {
+	return func(c Container) interface{} {
+		instance, err := f(c)
+		if err != nil {
+			return &bindingFailure{err: err}
+		}
+		return instance
+	}
+}
+
+// BindingFuncContext là factory function nhận context.Context của lượt resolve.
+//
+// BindingFuncContext được đăng ký qua BindContext, SingletonContext. ctx là context truyền vào
+// MakeContext/CallContext, hoặc context.Background() khi resolve qua Make/Call.
+type BindingFuncContext func(ctx context.Context, c Container) (interface{}, error)
+
+// binding chuyển BindingFuncContext thành BindingFunc, ctx được lấy từ resolver của lượt resolve.
+func (f BindingFuncContext) binding() BindingFunc {
+	return BindingFuncE(func(c Container) (interface{}, error) {
+		return f(contextOf(c), c)
+	}).binding()
+}
+
+// bindingFailure bọc lỗi phát sinh trong factory để truyền qua BindingFunc tới Make.
+//
+// Container nhận diện giá trị này sau khi gọi factory: trả lỗi về cho caller và không cache nó như singleton.
+type bindingFailure struct {
+	err error
+}
+
+// ExtenderFunc là decorator nhận instance đã resolve và trả về instance thay thế (thường bọc instance gốc).
+//
+// ExtenderFunc được đăng ký qua Extend; c là container của lượt resolve đang decorate instance.
+type ExtenderFunc func(instance interface{}, c Container) interface{}
+
+// lifetime là vòng đời của một binding, quyết định thời điểm extender được áp dụng.
+type lifetime int
+
+const (
+	// lifetimeTransient: factory được gọi mỗi lần resolve, extender áp dụng cho mỗi instance.
+	lifetimeTransient lifetime = iota
+
+	// lifetimeSingleton: instance được cache trên container, extender áp dụng một lần trước khi cache.
+	lifetimeSingleton
+
+	// lifetimeScoped: instance được cache trong scope, extender áp dụng một lần cho mỗi scope.
+	lifetimeScoped
+)
+
+// Params là tham số runtime truyền vào MakeWith, theo tên.
+//
+// Factory đăng ký qua BindWith đọc params qua tham số; tham số có tên trùng với một key
+// thay thế dependency đó trong các resolve lồng nhau của lượt MakeWith.
+type Params map[string]interface{}
+
+// BindingFuncWith là factory function nhận params của MakeWith.
+//
+// BindingFuncWith được đăng ký qua BindWith; params là nil khi resolve qua Make.
+type BindingFuncWith func(c Container, params Params) (interface{}, error)
+
+// binding chuyển BindingFuncWith thành BindingFunc, params được lấy từ resolver của lượt resolve.
+func (f BindingFuncWith) binding() BindingFunc {
+	return BindingFuncE(func(c Container) (interface{}, error) {
+		return f(c, paramsOf(c))
+	}).binding()
+}
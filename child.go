@@ -0,0 +1,99 @@
+package di
+
+import "context"
+
+// NewChild tạo một container con kế thừa mọi đăng ký của container hiện tại.
+//
+//   - Mục đích: Xây dựng container theo module hoặc theo test mà không phải sao chép từng binding.
+//   - Logic:
+//   - Make/Bound/BindIf trên container con tìm trong đăng ký của chính nó trước, sau đó mới tới container cha.
+//   - Bind/Instance/Alias/Singleton trên container con chỉ ghi đè trong container con.
+//   - Singleton đăng ký trên container cha vẫn được chia sẻ với container cha và được khởi tạo
+//     từ góc nhìn của container cha (không thấy override của container con).
+//   - Binding transient của container cha resolve dependency lồng nhau từ container con,
+//     nên override của container con được áp dụng.
+//   - Trả về: Container con rỗng, liên kết với container hiện tại.
+func (c *container) NewChild() Container {
+	child := New().(*container)
+	child.parent = c
+	return child
+}
+
+// canonical trả về key gốc của abstract sau khi resolve chuỗi alias bắc cầu, từ container hiện tại lên các container cha.
+//
+// Ở mỗi bước, alias của container con được ưu tiên hơn alias của container cha. Alias khép vòng bị từ chối khi đăng ký;
+// nếu vòng vẫn hình thành qua nhiều container, canonical dừng tại key cuối cùng trước khi lặp lại.
+func (c *container) canonical(abstract string) string {
+	var seen map[string]bool
+	for {
+		target, exists := c.aliasOf(abstract)
+		if !exists {
+			return abstract
+		}
+
+		if seen == nil {
+			seen = make(map[string]bool)
+		}
+		seen[abstract] = true
+		if seen[target] {
+			return abstract
+		}
+
+		abstract = target
+	}
+}
+
+// aliasOf trả về key mà alias trỏ tới (một bước), từ container hiện tại lên các container cha.
+func (c *container) aliasOf(alias string) (string, bool) {
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		target, exists := current.aliases[alias]
+		current.mu.RUnlock()
+
+		if exists {
+			return target, true
+		}
+	}
+
+	return "", false
+}
+
+// lookup tìm instance hoặc binding của abstract, từ container hiện tại lên các container cha.
+//
+// Trong cùng một container, instance được ưu tiên hơn binding; container con luôn được ưu tiên hơn container cha.
+// lt là vòng đời của binding tìm được (lifetimeTransient với instance); owner là container chứa đăng ký,
+// nil nếu không tìm thấy.
+func (c *container) lookup(abstract string) (instance interface{}, concrete BindingFunc, lt lifetime, owner *container) {
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		instance, isInstance := current.instances[abstract]
+		concrete, isBinding := current.bindings[abstract]
+		lt := current.lifetimes[abstract]
+		current.mu.RUnlock()
+
+		if isInstance {
+			return instance, nil, lifetimeTransient, current
+		}
+		if isBinding {
+			return nil, concrete, lt, current
+		}
+	}
+
+	return nil, nil, lifetimeTransient, nil
+}
+
+// sharedView trả về view dùng để khởi tạo instance dùng chung (singleton) của c.
+//
+// Resolve lồng nhau đi từ c thay vì từ container con đã yêu cầu, và không mang scope hay params của MakeWith,
+// để singleton không giữ override của container con, scoped instance của một scope cụ thể hay tham số của một lượt gọi.
+// Context và chuỗi resolve của lượt resolve hiện tại được giữ nguyên.
+func (c *container) sharedView(view Container) Container {
+	state := newResolveState(context.Background())
+	if r, ok := view.(*resolver); ok {
+		state = r.state
+		state.scope = nil
+		state.params = nil
+	}
+
+	return &resolver{container: c, state: state}
+}
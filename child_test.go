@@ -0,0 +1,107 @@
+package di
+
+import (
+	"testing"
+)
+
+// TestNewChildFallback kiểm tra container con fallback về container cha
+func TestNewChildFallback(t *testing.T) {
+	parent := New()
+	parent.Instance("config", "parent-config")
+	parent.Bind("service", func(c Container) interface{} {
+		return NewMockService("parent-service")
+	})
+	parent.Alias("service", "svc")
+
+	child := parent.NewChild()
+
+	if value, err := child.Make("config"); err != nil || value != "parent-config" {
+		t.Errorf("Container con nên resolve instance của container cha: %v, %v", value, err)
+	}
+
+	if service, err := child.Make("svc"); err != nil || service.(*MockService).ID != "parent-service" {
+		t.Errorf("Container con nên resolve alias của container cha: %v, %v", service, err)
+	}
+
+	if !child.Bound("config") || !child.Bound("svc") {
+		t.Error("Bound() trên container con nên thấy đăng ký của container cha")
+	}
+
+	if child.BindIf("service", func(c Container) interface{} { return "child" }) {
+		t.Error("BindIf() trên container con không nên override binding của container cha")
+	}
+}
+
+// TestNewChildOverride kiểm tra override trên container con không ảnh hưởng container cha
+func TestNewChildOverride(t *testing.T) {
+	parent := New()
+	parent.Instance("config", "parent-config")
+	parent.Bind("report", func(c Container) interface{} {
+		return "report with " + c.MustMake("config").(string)
+	})
+
+	child := parent.NewChild()
+	child.Instance("config", "child-config")
+
+	if value := child.MustMake("config"); value != "child-config" {
+		t.Errorf("Container con nên ưu tiên instance của chính nó, got: %v", value)
+	}
+	if value := parent.MustMake("config"); value != "parent-config" {
+		t.Errorf("Override trên container con không nên ảnh hưởng container cha, got: %v", value)
+	}
+
+	// Binding transient của container cha dùng override của container con
+	if report := child.MustMake("report"); report != "report with child-config" {
+		t.Errorf("Binding transient nên resolve dependency từ container con, got: %v", report)
+	}
+	if report := parent.MustMake("report"); report != "report with parent-config" {
+		t.Errorf("Container cha không nên thấy override của container con, got: %v", report)
+	}
+
+	// Reset container con không xoá đăng ký của container cha
+	child.Reset()
+	if value := child.MustMake("config"); value != "parent-config" {
+		t.Errorf("Sau Reset, container con nên fallback về container cha, got: %v", value)
+	}
+}
+
+// TestNewChildSharedSingleton kiểm tra singleton của container cha được chia sẻ
+func TestNewChildSharedSingleton(t *testing.T) {
+	parent := New()
+	parent.Instance("dsn", "parent-dsn")
+
+	calls := 0
+	parent.Singleton("db", func(c Container) interface{} {
+		calls++
+		return &MockDependencyA{Value: c.MustMake("dsn").(string)}
+	})
+
+	child := parent.NewChild()
+	child.Instance("dsn", "child-dsn")
+
+	// Singleton được khởi tạo lần đầu qua container con vẫn thuộc về container cha
+	fromChild := child.MustMake("db").(*MockDependencyA)
+	fromParent := parent.MustMake("db").(*MockDependencyA)
+
+	if fromChild != fromParent || calls != 1 {
+		t.Errorf("Singleton của container cha nên được chia sẻ, calls: %d", calls)
+	}
+	if fromChild.Value != "parent-dsn" {
+		t.Errorf("Singleton của container cha không nên dùng override của container con, got: %s", fromChild.Value)
+	}
+
+	// Singleton đăng ký trên container con chỉ thuộc container con
+	child.Singleton("cache", func(c Container) interface{} {
+		return NewMockService("child-cache")
+	})
+	child.MustMake("cache")
+	if parent.Bound("cache") {
+		t.Error("Singleton của container con không nên xuất hiện trên container cha")
+	}
+
+	// Container con nhiều cấp
+	grandchild := child.NewChild()
+	if grandchild.MustMake("dsn") != "child-dsn" || grandchild.MustMake("db") != fromParent {
+		t.Error("Container cháu nên fallback qua từng cấp container cha")
+	}
+}
@@ -0,0 +1,229 @@
+package di
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// Invoker là callback đã được Compile phân tích sẵn, gọi lại được nhiều lần mà không phải phân tích lại.
+type Invoker interface {
+	// Call gọi callback như Container.Call.
+	Call(additionalParams ...interface{}) ([]interface{}, error)
+
+	// CallContext gọi callback như Container.CallContext.
+	CallContext(ctx context.Context, additionalParams ...interface{}) ([]interface{}, error)
+
+	// Invoke gọi callback như Container.Invoke: error cuối cùng của callback được trả về như lỗi.
+	Invoke(additionalParams ...interface{}) ([]interface{}, error)
+}
+
+// paramKind phân loại cách Call tìm đối số cho một tham số.
+type paramKind int
+
+const (
+	// paramResolve resolve tham số từ container theo kiểu.
+	paramResolve paramKind = iota
+
+	// paramContainer inject Container của lượt gọi.
+	paramContainer
+
+	// paramContext inject context.Context của lượt gọi.
+	paramContext
+
+	// paramApplication resolve Application theo kiểu, sau đó tới key "app".
+	paramApplication
+)
+
+// paramPlan là thông tin đã tính sẵn của một tham số.
+type paramPlan struct {
+	// typ là kiểu của tham số; với tham số variadic là kiểu slice.
+	typ reflect.Type
+
+	// kind là cách tìm đối số cho tham số.
+	kind paramKind
+
+	// key là TypeKey của typ.
+	key string
+
+	// shortKey là tên kiểu ngắn (typ.String()), dùng khi key chưa được đăng ký.
+	shortKey string
+}
+
+// callPlan là kế hoạch gọi một kiểu callback: kiểu và key resolve của từng tham số.
+type callPlan struct {
+	// numIn là số tham số của callback, kể cả tham số variadic.
+	numIn int
+
+	// params là các tham số cố định theo thứ tự.
+	params []paramPlan
+
+	// variadic là tham số variadic, nil nếu callback không variadic.
+	variadic *paramPlan
+
+	// returnsError đánh dấu kết quả cuối cùng của callback có kiểu error.
+	returnsError bool
+}
+
+// callPlans cache callPlan theo reflect.Type của callback; số phần tử bị giới hạn bởi số kiểu func trong chương trình.
+var callPlans sync.Map
+
+// planFor trả về callPlan của kiểu func t, tạo và cache nếu chưa có.
+func planFor(t reflect.Type) *callPlan {
+	if plan, ok := callPlans.Load(t); ok {
+		return plan.(*callPlan)
+	}
+
+	plan, _ := callPlans.LoadOrStore(t, newCallPlan(t))
+	return plan.(*callPlan)
+}
+
+// newCallPlan phân tích kiểu func t thành callPlan.
+func newCallPlan(t reflect.Type) *callPlan {
+	plan := &callPlan{numIn: t.NumIn()}
+
+	fixed := t.NumIn()
+	if t.IsVariadic() {
+		fixed--
+		variadic := newParamPlan(t.In(fixed))
+		plan.variadic = &variadic
+	}
+
+	plan.params = make([]paramPlan, fixed)
+	for i := range plan.params {
+		plan.params[i] = newParamPlan(t.In(i))
+	}
+
+	plan.returnsError = t.NumOut() > 0 && t.Out(t.NumOut()-1) == errorType
+	return plan
+}
+
+// newParamPlan tính sẵn cách tìm đối số cho tham số kiểu t.
+func newParamPlan(t reflect.Type) paramPlan {
+	param := paramPlan{typ: t, key: TypeKey(t), shortKey: t.String()}
+
+	switch t {
+	case containerType:
+		param.kind = paramContainer
+	case contextType:
+		param.kind = paramContext
+	case applicationType:
+		param.kind = paramApplication
+	}
+
+	return param
+}
+
+// invoker là hiện thực của Invoker.
+type invoker struct {
+	// container là container resolve các tham số.
+	container *container
+
+	// state là trạng thái gốc của mỗi lượt gọi (giữ scope của container đã Compile).
+	state resolveState
+
+	// fn là callback.
+	fn reflect.Value
+
+	// plan là kế hoạch gọi của kiểu callback.
+	plan *callPlan
+
+	// abstracts là key gốc (đã resolve alias) của từng tham số cố định, chọn khi Compile;
+	// "" với tham số không resolve từ container hoặc chưa có key nào được đăng ký khi Compile.
+	abstracts []string
+}
+
+// Compile phân tích callback một lần và trả về Invoker để gọi lại nhiều lần.
+//
+//   - Mục đích: Giảm chi phí reflection khi gọi cùng một handler cho mỗi request.
+//   - Logic: Kiểu và key resolve của từng tham số được tính sẵn và cache theo kiểu callback
+//     (Call cũng dùng cache này). Với mỗi tham số, lựa chọn giữa TypeKey và tên kiểu ngắn được cố định
+//     khi Compile nếu một trong hai đã được đăng ký; tham số chưa có key nào được đăng ký được chọn lại ở mỗi lần gọi.
+//     Tham số vẫn được resolve ở mỗi lần gọi, nên binding đăng ký lại sau Compile (cùng key) vẫn có hiệu lực.
+//     Key đã chọn được resolve alias khi Compile; nếu key có instance đã cache, instance được dùng trực tiếp
+//     mà không đi qua các bước resolve (alias, contextual binding, tham số MakeWith) của Call.
+//     Invoker tạo từ scope resolve binding scoped trong scope đó.
+//   - Tham số:
+//   - callback: interface{} — function cần gọi.
+//   - Trả về:
+//   - Invoker: callback đã biên dịch.
+//   - error: ErrNotAFunction nếu callback không phải function.
+func (c *container) Compile(callback interface{}) (Invoker, error) {
+	return c.compile(newResolveState(context.Background()), callback)
+}
+
+// Compile biên dịch callback; Invoker giữ scope của lượt resolve hiện tại nhưng bắt đầu chuỗi resolve mới
+// ở mỗi lần gọi, vì nó có thể được gọi sau khi lượt resolve hiện tại đã kết thúc.
+func (r *resolver) Compile(callback interface{}) (Invoker, error) {
+	return r.compile(resolveState{ctx: context.Background(), scope: r.state.scope}, callback)
+}
+
+// compile là hiện thực nội bộ của Compile.
+func (c *container) compile(state resolveState, callback interface{}) (Invoker, error) {
+	callbackType := reflect.TypeOf(callback)
+	if callbackType == nil || callbackType.Kind() != reflect.Func {
+		return nil, ErrNotAFunction
+	}
+
+	plan := planFor(callbackType)
+	return &invoker{container: c, state: state, fn: reflect.ValueOf(callback), plan: plan, abstracts: c.compileAbstracts(plan)}, nil
+}
+
+// compileAbstracts chọn sẵn key resolve cho các tham số cố định của plan theo các binding hiện tại.
+func (c *container) compileAbstracts(plan *callPlan) []string {
+	abstracts := make([]string, len(plan.params))
+	for i := range plan.params {
+		param := &plan.params[i]
+		if param.kind == paramContainer || param.kind == paramContext {
+			continue
+		}
+		if abstract := c.paramAbstract(param); c.Bound(abstract) {
+			abstracts[i] = c.canonical(abstract)
+		}
+	}
+	return abstracts
+}
+
+// cachedValue trả về instance đã cache của abstract (key gốc chọn khi Compile) mà không đi qua resolve:
+// alias đã được xử lý khi Compile, Invoker không mang tham số MakeWith và không có consumer cho contextual binding.
+// Trả về false nếu abstract là binding, ctx đã bị huỷ hoặc instance không gán được cho kiểu t;
+// khi đó tham số được resolve như Call.
+func (c *container) cachedValue(state resolveState, abstract string, t reflect.Type) (reflect.Value, bool) {
+	if state.ctx.Err() != nil {
+		return reflect.Value{}, false
+	}
+
+	instance, concrete, _, owner := c.lookup(abstract)
+	if owner == nil || concrete != nil {
+		return reflect.Value{}, false
+	}
+
+	value := reflect.ValueOf(instance)
+	if !value.IsValid() || !value.Type().AssignableTo(t) {
+		return reflect.Value{}, false
+	}
+
+	owner.markResolved(abstract)
+	return value, true
+}
+
+// Call gọi callback như Container.Call.
+func (i *invoker) Call(additionalParams ...interface{}) ([]interface{}, error) {
+	return i.container.callPlan(i.state, i.plan, i.abstracts, i.fn, additionalParams)
+}
+
+// CallContext gọi callback, các tham số được resolve với ctx.
+func (i *invoker) CallContext(ctx context.Context, additionalParams ...interface{}) ([]interface{}, error) {
+	state := i.state
+	state.ctx = ctx
+	return i.container.callPlan(state, i.plan, i.abstracts, i.fn, additionalParams)
+}
+
+// Invoke gọi callback và trả về error cuối cùng của callback như lỗi.
+func (i *invoker) Invoke(additionalParams ...interface{}) ([]interface{}, error) {
+	results, err := i.container.callPlan(i.state, i.plan, i.abstracts, i.fn, additionalParams)
+	if err != nil {
+		return nil, err
+	}
+	return splitError(i.plan, results)
+}
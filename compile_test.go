@@ -0,0 +1,226 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestCompile kiểm tra Invoker tạo bởi Compile
+func TestCompile(t *testing.T) {
+	container := New()
+
+	handler := func(service *MockService, name string, tags ...string) (string, error) {
+		if service == nil {
+			return "", errors.New("missing service")
+		}
+		return service.ID + ":" + name + ":" + string(rune('0'+len(tags))), nil
+	}
+
+	invoker, err := container.Compile(handler)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	// Binding đăng ký sau Compile vẫn có hiệu lực
+	if _, err := invoker.Call("name"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Invoker nên trả về lỗi resolve khi dependency chưa đăng ký, got: %v", err)
+	}
+	ProvideInstance(container, NewMockService("svc"))
+
+	results, err := invoker.Invoke("name", "a", "b")
+	if err != nil || len(results) != 1 || results[0] != "svc:name:2" {
+		t.Errorf("Invoke nên resolve tham số và tách error, got: %v, %v", results, err)
+	}
+
+	results, err = invoker.Call("other")
+	if err != nil || len(results) != 2 || results[0] != "svc:other:0" {
+		t.Errorf("Call nên trả về cả error trong kết quả, got: %v, %v", results, err)
+	}
+
+	type ctxKey struct{}
+	ctxInvoker, _ := container.Compile(func(ctx context.Context) interface{} { return ctx.Value(ctxKey{}) })
+	results, err = ctxInvoker.CallContext(context.WithValue(context.Background(), ctxKey{}, "request"))
+	if err != nil || results[0] != "request" {
+		t.Errorf("CallContext nên truyền ctx cho tham số context.Context, got: %v, %v", results, err)
+	}
+
+	if _, err := container.Compile("not a function"); !errors.Is(err, ErrNotAFunction) {
+		t.Errorf("Compile với giá trị không phải function nên trả về ErrNotAFunction, got: %v", err)
+	}
+}
+
+// TestCompileScope kiểm tra Invoker tạo từ scope resolve binding scoped trong scope đó
+func TestCompileScope(t *testing.T) {
+	container := New()
+	container.Scoped("*di.MockService", func(c Container) interface{} { return NewMockService("request") })
+
+	scope := container.NewScope()
+	invoker, err := scope.Compile(func(service *MockService) *MockService { return service })
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	results, err := invoker.Call()
+	if err != nil || results[0] != scope.MustMake("*di.MockService") {
+		t.Errorf("Invoker của scope nên resolve binding scoped trong scope, got: %v, %v", results, err)
+	}
+
+	rootInvoker, _ := container.Compile(func(service *MockService) {})
+	if _, err := rootInvoker.Call(); !errors.Is(err, ErrScopeRequired) {
+		t.Errorf("Invoker của container gốc không nên resolve binding scoped, got: %v", err)
+	}
+}
+
+// TestCompileKeys kiểm tra key của tham số được chọn khi Compile
+func TestCompileKeys(t *testing.T) {
+	container := New()
+	container.Instance("*di.MockService", NewMockService("short"))
+
+	handler := func(service *MockService, repo *userRepo) string { return service.ID + ":" + repo.DSN }
+	compiled, err := container.Compile(handler)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	impl := compiled.(*invoker)
+	if impl.abstracts[0] != "*di.MockService" || impl.abstracts[1] != "" {
+		t.Errorf("Compile nên chọn sẵn key đã đăng ký và để trống key chưa đăng ký, got: %q", impl.abstracts)
+	}
+
+	// Tham số đã chọn key giữ nguyên lựa chọn; tham số chưa chọn được chọn ở mỗi lần gọi
+	ProvideInstance(container, NewMockService("typed"))
+	ProvideInstance(container, &userRepo{DSN: "memory"})
+
+	results, err := compiled.Call()
+	if err != nil || results[0] != "short:memory" {
+		t.Errorf("Invoker nên dùng key chọn khi Compile, got: %v, %v", results, err)
+	}
+
+	results, err = container.Call(handler)
+	if err != nil || results[0] != "typed:memory" {
+		t.Errorf("Call nên chọn key theo binding hiện tại, got: %v, %v", results, err)
+	}
+}
+
+// TestCompileCachedInstance kiểm tra Invoker dùng trực tiếp instance đã cache của key chọn khi Compile
+func TestCompileCachedInstance(t *testing.T) {
+	container := New()
+	container.Instance("service", NewMockService("first"))
+	container.Alias("service", "*di.MockService")
+
+	compiled, err := container.Compile(func(service *MockService) string { return service.ID })
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	if impl := compiled.(*invoker); impl.abstracts[0] != "service" {
+		t.Errorf("Compile nên lưu key gốc của alias, got: %q", impl.abstracts)
+	}
+
+	var rebound interface{}
+	container.Rebinding("service", func(c Container, instance interface{}) { rebound = instance })
+
+	results, err := compiled.Call()
+	if err != nil || results[0] != "first" {
+		t.Fatalf("Invoker nên dùng instance đã cache, got: %v, %v", results, err)
+	}
+
+	// Lời gọi qua Invoker vẫn đánh dấu key đã được resolve cho Rebinding
+	second := NewMockService("second")
+	container.Instance("service", second)
+	if rebound != second {
+		t.Errorf("Rebinding nên được gọi sau khi Invoker đã resolve key, got: %v", rebound)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := compiled.CallContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("CallContext với ctx đã huỷ nên trả về lỗi, got: %v", err)
+	}
+}
+
+// TestCallPlanCache kiểm tra plan được cache theo kiểu callback
+func TestCallPlanCache(t *testing.T) {
+	handlerType := reflect.TypeOf(func(Container, context.Context, Application, *MockService, ...string) error { return nil })
+
+	plan := planFor(handlerType)
+	if planFor(handlerType) != plan {
+		t.Error("planFor nên trả về plan đã cache cho cùng kiểu callback")
+	}
+
+	kinds := []paramKind{paramContainer, paramContext, paramApplication, paramResolve}
+	if len(plan.params) != len(kinds) || plan.variadic == nil || !plan.returnsError || plan.numIn != 5 {
+		t.Fatalf("Plan không khớp kiểu callback: %+v", plan)
+	}
+	for i, kind := range kinds {
+		if plan.params[i].kind != kind {
+			t.Errorf("params[%d].kind = %d, expected %d", i, plan.params[i].kind, kind)
+		}
+	}
+	if plan.params[3].key != KeyOf[*MockService]() || plan.params[3].shortKey != "*di.MockService" {
+		t.Errorf("Key của tham số không đúng: %+v", plan.params[3])
+	}
+}
+
+// benchmarkHandler là handler HTTP dùng trong các benchmark Call
+func benchmarkHandler(service *MockService, repo *userRepo, w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// setupBenchmarkCall tạo container cho các benchmark Call; shortKeys đăng ký instance theo tên kiểu ngắn thay vì TypeKey
+func setupBenchmarkCall(shortKeys bool) (Container, http.ResponseWriter, *http.Request) {
+	container := New()
+	if shortKeys {
+		container.Instance("*di.MockService", NewMockService("service"))
+		container.Instance("*di.userRepo", &userRepo{DSN: "memory"})
+	} else {
+		ProvideInstance(container, NewMockService("service"))
+		ProvideInstance(container, &userRepo{DSN: "memory"})
+	}
+	return container, httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil)
+}
+
+func BenchmarkContainerCallHandler(b *testing.B) {
+	container, w, r := setupBenchmarkCall(false)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := container.Call(benchmarkHandler, w, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkContainerCallShortKeys đo Call với instance đăng ký theo tên kiểu ngắn:
+// TypeKey của tham số chưa được đăng ký nên mỗi tham số tốn thêm một lần tra cứu trước khi dùng tên kiểu ngắn.
+func BenchmarkContainerCallShortKeys(b *testing.B) {
+	container, w, r := setupBenchmarkCall(true)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := container.Call(benchmarkHandler, w, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkContainerCompiledCall(b *testing.B) {
+	container, w, r := setupBenchmarkCall(false)
+	invoker, err := container.Compile(benchmarkHandler)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := invoker.Call(w, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
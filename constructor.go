@@ -0,0 +1,79 @@
+package di
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// BindConstructor đăng ký một constructor Go thông thường làm binding transient.
+//
+//   - Mục đích: Đăng ký trực tiếp `func NewUserService(db *sql.DB, log *slog.Logger) (*UserService, error)`
+//     thay vì viết tay factory resolve từng dependency.
+//   - Logic:
+//   - Mỗi lần resolve, các tham số của constructor được resolve như Call (KeyOf của kiểu tham số,
+//     sau đó tới tên kiểu ngắn), rồi constructor được gọi.
+//   - Kết quả được đăng ký dưới key KeyOf của kiểu trả về, nên dùng được với Resolve[T] và làm tham số
+//     cho các constructor khác.
+//   - Lỗi resolve tham số (*ParameterError) hoặc lỗi constructor trả về được Make trả lại cho caller.
+//   - Tham số:
+//   - constructor: interface{} — function dạng func(...) T hoặc func(...) (T, error).
+//   - keys: ...string — key bổ sung, được đăng ký làm alias tới key theo kiểu.
+//   - Trả về: error — ErrInvalidConstructor nếu constructor không có một trong hai dạng trên;
+//     *AliasError (wrap ErrAliasCycle) nếu một key bổ sung tạo thành vòng alias. Khi có lỗi, không có gì được đăng ký.
+func (c *container) BindConstructor(constructor interface{}, keys ...string) error {
+	return c.registerConstructor(constructor, keys, c.BindE)
+}
+
+// SingletonConstructor như BindConstructor nhưng constructor chỉ được gọi một lần.
+//
+// Nếu constructor trả về lỗi, instance không được cache và lần resolve sau gọi lại constructor.
+func (c *container) SingletonConstructor(constructor interface{}, keys ...string) error {
+	return c.registerConstructor(constructor, keys, c.SingletonE)
+}
+
+// ScopedConstructor như BindConstructor nhưng instance được chia sẻ trong mỗi scope.
+func (c *container) ScopedConstructor(constructor interface{}, keys ...string) error {
+	return c.registerConstructor(constructor, keys, func(abstract string, concrete BindingFuncE) {
+		c.Scoped(abstract, concrete.binding())
+	})
+}
+
+// registerConstructor kiểm tra constructor, đăng ký nó dưới key theo kiểu trả về qua register
+// và alias các key bổ sung tới key đó.
+func (c *container) registerConstructor(constructor interface{}, keys []string, register func(string, BindingFuncE)) error {
+	constructorType := reflect.TypeOf(constructor)
+	if !isConstructorType(constructorType) {
+		return fmt.Errorf("%w, got %T", ErrInvalidConstructor, constructor)
+	}
+
+	abstract := TypeKey(constructorType.Out(0))
+
+	// Key bổ sung được kiểm tra trước khi đăng ký để không để lại đăng ký dở dang
+	for _, key := range keys {
+		if err := c.checkAlias(abstract, key); err != nil {
+			return err
+		}
+	}
+
+	register(abstract, func(container Container) (interface{}, error) {
+		results, err := container.Invoke(constructor)
+		if err != nil {
+			return nil, err
+		}
+		return results[0], nil
+	})
+
+	for _, key := range keys {
+		c.Alias(abstract, key)
+	}
+
+	return nil
+}
+
+// isConstructorType kiểm tra t có dạng func(...) T hoặc func(...) (T, error), với T khác error.
+func isConstructorType(t reflect.Type) bool {
+	if t == nil || t.Kind() != reflect.Func || t.NumOut() == 0 || t.Out(0) == errorType {
+		return false
+	}
+	return t.NumOut() == 1 || (t.NumOut() == 2 && t.Out(1) == errorType)
+}
@@ -0,0 +1,126 @@
+package di
+
+import (
+	"errors"
+	"testing"
+)
+
+// userRepo và userService là các kiểu dùng để kiểm tra BindConstructor
+type userRepo struct {
+	DSN string
+}
+
+type userService struct {
+	Repo   *userRepo
+	Logger *MockService
+}
+
+// errMissingDSN là lỗi newUserService trả về khi repo chưa được cấu hình
+var errMissingDSN = errors.New("missing dsn")
+
+func newUserRepo() *userRepo {
+	return &userRepo{DSN: "memory"}
+}
+
+func newUserService(repo *userRepo, logger *MockService) (*userService, error) {
+	if repo.DSN == "" {
+		return nil, errMissingDSN
+	}
+	return &userService{Repo: repo, Logger: logger}, nil
+}
+
+// TestBindConstructor kiểm tra constructor được wire theo kiểu tham số và kiểu trả về
+func TestBindConstructor(t *testing.T) {
+	container := New()
+
+	ProvideInstance(container, NewMockService("logger"))
+	if err := container.SingletonConstructor(newUserRepo); err != nil {
+		t.Fatalf("SingletonConstructor failed: %v", err)
+	}
+	if err := container.BindConstructor(newUserService, "users"); err != nil {
+		t.Fatalf("BindConstructor failed: %v", err)
+	}
+
+	service, err := Resolve[*userService](container)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if service.Repo.DSN != "memory" || service.Logger.ID != "logger" {
+		t.Errorf("Constructor nên nhận dependency theo kiểu, got: %+v", service)
+	}
+
+	byKey := container.MustMake("users").(*userService)
+	if byKey == service {
+		t.Error("BindConstructor nên tạo instance mới mỗi lần resolve")
+	}
+	if byKey.Repo != service.Repo {
+		t.Error("SingletonConstructor nên chia sẻ một instance")
+	}
+
+	// Call cũng resolve được kiểu đăng ký qua constructor
+	results, err := container.Call(func(repo *userRepo) string { return repo.DSN })
+	if err != nil || results[0] != "memory" {
+		t.Errorf("Call nên resolve kiểu đăng ký qua constructor, got: %v, %v", results, err)
+	}
+}
+
+// TestBindConstructorErrors kiểm tra lỗi của constructor và constructor không hợp lệ
+func TestBindConstructorErrors(t *testing.T) {
+	container := New()
+
+	ProvideInstance(container, NewMockService("logger"))
+	ProvideInstance(container, &userRepo{})
+	container.BindConstructor(newUserService)
+
+	if _, err := Resolve[*userService](container); !errors.Is(err, errMissingDSN) {
+		t.Errorf("Lỗi của constructor nên được trả về, got: %v", err)
+	}
+
+	// Dependency chưa đăng ký trả về ParameterError
+	container.Forget(KeyOf[*MockService]())
+	var paramErr *ParameterError
+	if _, err := Resolve[*userService](container); !errors.As(err, &paramErr) || paramErr.Index != 1 || !errors.Is(err, ErrNotFound) {
+		t.Errorf("Dependency chưa đăng ký nên trả về ParameterError, got: %v", err)
+	}
+
+	invalid := []interface{}{nil, "not a function", func() {}, func() (int, string) { return 0, "" }, func() error { return nil }}
+	for _, constructor := range invalid {
+		if err := container.BindConstructor(constructor); !errors.Is(err, ErrInvalidConstructor) {
+			t.Errorf("Constructor %T không hợp lệ nên trả về ErrInvalidConstructor, got: %v", constructor, err)
+		}
+	}
+}
+
+// TestBindConstructorAliasError kiểm tra key bổ sung tạo vòng alias trả về lỗi thay vì panic
+func TestBindConstructorAliasError(t *testing.T) {
+	container := New()
+
+	var aliasErr *AliasError
+	err := container.BindConstructor(newUserRepo, "repo", KeyOf[*userRepo]())
+	if !errors.As(err, &aliasErr) || !errors.Is(err, ErrAliasCycle) {
+		t.Fatalf("Key bổ sung trùng key theo kiểu nên trả về *AliasError, got: %v", err)
+	}
+
+	if container.Bound(KeyOf[*userRepo]()) || container.Bound("repo") {
+		t.Error("Constructor lỗi không nên để lại đăng ký dở dang")
+	}
+}
+
+// TestScopedConstructor kiểm tra constructor scoped chia sẻ instance trong từng scope
+func TestScopedConstructor(t *testing.T) {
+	container := New()
+	container.ScopedConstructor(newUserRepo)
+
+	if _, err := Resolve[*userRepo](container); !errors.Is(err, ErrScopeRequired) {
+		t.Errorf("Constructor scoped ngoài scope nên trả về ErrScopeRequired, got: %v", err)
+	}
+
+	scope := container.NewScope()
+	first := scope.MustMake(KeyOf[*userRepo]())
+	if scope.MustMake(KeyOf[*userRepo]()) != first {
+		t.Error("Constructor scoped nên chia sẻ instance trong scope")
+	}
+	if container.NewScope().MustMake(KeyOf[*userRepo]()) == first {
+		t.Error("Mỗi scope nên có instance riêng")
+	}
+}
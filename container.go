@@ -1,6 +1,8 @@
 package di
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -20,10 +22,64 @@ type Container interface {
 	// Singleton đăng ký binding singleton (chỉ tạo một instance duy nhất).
 	Singleton(abstract string, concrete BindingFunc)
 
+	// BindE đăng ký binding với factory có thể trả về lỗi.
+	BindE(abstract string, concrete BindingFuncE)
+
+	// BindIfE đăng ký binding với factory có thể trả về lỗi, chỉ khi chưa tồn tại.
+	BindIfE(abstract string, concrete BindingFuncE) bool
+
+	// SingletonE đăng ký singleton với factory có thể trả về lỗi; instance lỗi không được cache.
+	SingletonE(abstract string, concrete BindingFuncE)
+
+	// BindContext đăng ký binding với factory nhận context của lượt resolve.
+	BindContext(abstract string, concrete BindingFuncContext)
+
+	// SingletonContext đăng ký singleton với factory nhận context của lượt resolve đầu tiên.
+	SingletonContext(abstract string, concrete BindingFuncContext)
+
+	// BindWith đăng ký binding với factory nhận tham số runtime của MakeWith.
+	BindWith(abstract string, concrete BindingFuncWith)
+
+	// Scoped đăng ký binding scoped: một instance được chia sẻ trong mỗi scope.
+	Scoped(abstract string, concrete BindingFunc)
+
+	// NewScope tạo một scope mới; binding scoped được cache trong scope cho tới khi End.
+	NewScope() Scope
+
+	// NewChild tạo container con: tìm đăng ký của chính nó trước, sau đó tới container cha.
+	NewChild() Container
+
+	// Tag gắn tag cho các abstract; Tagged resolve chúng theo thứ tự đăng ký.
+	Tag(tag string, abstracts ...string)
+
+	// Tagged resolve tất cả abstract mang tag; lỗi của từng abstract được tổng hợp.
+	Tagged(tag string) ([]interface{}, error)
+
+	// When bắt đầu đăng ký contextual binding: implementation của abstract phụ thuộc vào consumer.
+	When(consumers ...string) ContextualBindingBuilder
+
+	// Extend đăng ký decorator cho abstract; decorator được áp dụng theo thứ tự đăng ký.
+	Extend(abstract string, fn ExtenderFunc)
+
+	// Resolving đăng ký hook được gọi mỗi khi abstract được factory tạo ra (singleton: chỉ lần đầu).
+	Resolving(abstract string, fn ResolvingFunc)
+
+	// AfterResolving đăng ký hook được gọi sau các hook Resolving của abstract.
+	AfterResolving(abstract string, fn ResolvingFunc)
+
+	// ResolvingAny đăng ký hook Resolving cho mọi abstract.
+	ResolvingAny(fn ResolvingFunc)
+
+	// AfterResolvingAny đăng ký hook AfterResolving cho mọi abstract.
+	AfterResolvingAny(fn ResolvingFunc)
+
+	// Rebinding đăng ký callback được gọi khi một abstract đã được resolve bị đăng ký lại.
+	Rebinding(abstract string, fn RebindingFunc)
+
 	// Instance đăng ký một instance đã khởi tạo sẵn.
 	Instance(abstract string, instance interface{})
 
-	// Alias đăng ký một alias cho abstract type.
+	// Alias đăng ký một alias cho abstract type; chuỗi alias được resolve bắc cầu, alias khép vòng gây panic.
 	Alias(abstract, alias string)
 
 	// Make resolve một dependency từ container.
@@ -32,14 +88,62 @@ type Container interface {
 	// MustMake resolve một dependency, panic nếu lỗi.
 	MustMake(abstract string) interface{}
 
+	// MakeContext resolve một dependency với context; trả về sớm nếu ctx bị huỷ.
+	MakeContext(ctx context.Context, abstract string) (interface{}, error)
+
+	// Forget xoá binding và instance của abstract; InvalidateDependents bỏ cache các singleton phụ thuộc.
+	Forget(abstract string, opts ...ForgetOption)
+
+	// ForgetInstance xoá instance (hoặc singleton đã cache) của abstract, giữ nguyên binding.
+	ForgetInstance(abstract string, opts ...ForgetOption)
+
+	// RemoveAlias xoá một alias.
+	RemoveAlias(alias string)
+
+	// MakeWith resolve một dependency với tham số runtime; singleton và scoped từ chối tham số.
+	MakeWith(abstract string, params Params) (interface{}, error)
+
 	// Bound kiểm tra một abstract đã được đăng ký binding/instance/alias chưa.
 	Bound(abstract string) bool
 
-	// Reset xóa toàn bộ binding, instance, alias khỏi container.
+	// Reset xóa toàn bộ binding, instance, alias, tag, contextual binding, extender, hook, callback Rebinding khỏi container.
 	Reset()
 
+	// BindConstructor đăng ký constructor func(...) T hoặc func(...) (T, error) dưới key KeyOf của T.
+	BindConstructor(constructor interface{}, keys ...string) error
+
+	// SingletonConstructor như BindConstructor nhưng constructor chỉ được gọi một lần.
+	SingletonConstructor(constructor interface{}, keys ...string) error
+
+	// ScopedConstructor như BindConstructor nhưng instance được chia sẻ trong mỗi scope.
+	ScopedConstructor(constructor interface{}, keys ...string) error
+
+	// Close giải phóng các instance và singleton mà container đã tạo hoặc được giao, theo thứ tự ngược.
+	Close(ctx context.Context) error
+
+	// Describe liệt kê mọi key đã đăng ký: loại, alias, trạng thái khởi tạo, kiểu instance và nơi đăng ký.
+	Describe() []BindingInfo
+
+	// Graph trả về đồ thị phụ thuộc đã ghi nhận khi factory resolve các key khác (xuất DOT, Mermaid, JSON).
+	Graph() DependencyGraph
+
+	// Validate resolve thử mọi binding (hoặc chỉ singleton) trong scope riêng và tổng hợp mọi lỗi wiring.
+	Validate(opts ...ValidateOption) error
+
+	// Inject điền các field exported của struct (theo tag `di:"key,optional,lazy"` hoặc theo kiểu).
+	Inject(target interface{}) error
+
 	// Call gọi một hàm và tự động resolve các dependency qua reflection.
 	Call(callback interface{}, additionalParams ...interface{}) ([]interface{}, error)
+
+	// Invoke gọi một hàm như Call; error cuối cùng của hàm được trả về như lỗi của Invoke.
+	Invoke(callback interface{}, additionalParams ...interface{}) ([]interface{}, error)
+
+	// Compile phân tích callback một lần và trả về Invoker gọi lại được nhiều lần.
+	Compile(callback interface{}) (Invoker, error)
+
+	// CallContext gọi một hàm, các dependency được resolve với context.
+	CallContext(ctx context.Context, callback interface{}, additionalParams ...interface{}) ([]interface{}, error)
 }
 
 // container là hiện thực cụ thể của Container interface.
@@ -65,7 +169,21 @@ type Container interface {
 //   - bindings: map[string]BindingFunc — ánh xạ abstract type (tên logic) tới factory function khởi tạo instance.
 //   - instances: map[string]interface{} — lưu trữ các singleton instance đã được khởi tạo.
 //   - aliases: map[string]string — ánh xạ alias tới abstract type gốc, hỗ trợ truy cập đa tên.
+//   - building: map[string]*pendingInstance — các singleton đang khởi tạo, mỗi key được khởi tạo đúng một lần.
+//   - tags: map[string][]string — ánh xạ tag tới các abstract mang tag, theo thứ tự đăng ký.
+//   - contextual: []contextualBinding — các rule contextual binding (consumer, abstract, factory).
+//   - extenders: []extender — các decorator (abstract, ExtenderFunc) theo thứ tự đăng ký.
+//   - hooks: []resolvingHook — các hook Resolving/AfterResolving theo thứ tự đăng ký.
+//   - rebindings: []rebindingCallback — các callback Rebinding theo thứ tự đăng ký.
+//   - resolved: map[string]bool — các key đăng ký trên container này đã từng được resolve.
+//   - dependents: map[string]map[string]struct{} — ánh xạ dependency tới các key đã resolve nó trong factory.
+//   - lifetimes: map[string]lifetime — vòng đời của các binding singleton/scoped (binding không có mục là transient).
+//   - contextFactories: map[string]bool — các binding có factory nhận context.Context (BindContext, SingletonContext).
+//   - paramFactories: map[string]bool — các binding có factory nhận Params của MakeWith (BindWith).
+//   - parent: *container — container cha của container con tạo bởi NewChild; lookup đi từ con lên cha.
 //   - mu: sync.RWMutex — đảm bảo an toàn concurrent cho mọi thao tác đăng ký/resolve.
+//   - extendVersion: uint64 — phiên bản của danh sách decorator, tăng mỗi lần Extend đăng ký decorator.
+//   - decoratedVersions: map[string]uint64 — phiên bản danh sách decorator mà instance của mỗi key đã được decorate theo.
 type container struct {
 	// bindings chứa các factory function tạo dependency theo abstract type.
 	bindings map[string]BindingFunc
@@ -76,17 +194,79 @@ type container struct {
 	// aliases ánh xạ alias tới abstract type gốc.
 	aliases map[string]string
 
+	// building chứa các singleton đang được khởi tạo, theo key.
+	building map[string]*pendingInstance
+
+	// tags ánh xạ tag tới các abstract mang tag, theo thứ tự đăng ký.
+	tags map[string][]string
+
+	// contextual chứa các rule contextual binding theo thứ tự đăng ký.
+	contextual []contextualBinding
+
+	// extenders chứa các decorator theo thứ tự đăng ký.
+	extenders []extender
+
+	// hooks chứa các hook Resolving/AfterResolving theo thứ tự đăng ký.
+	hooks []resolvingHook
+
+	// rebindings chứa các callback Rebinding theo thứ tự đăng ký.
+	rebindings []rebindingCallback
+
+	// resolved đánh dấu các key đăng ký trên container này đã từng được resolve.
+	resolved map[string]bool
+
+	// dependents ánh xạ dependency tới các key đã resolve nó trong factory.
+	dependents map[string]map[string]struct{}
+
+	// lifetimes chứa vòng đời của các binding không phải transient.
+	lifetimes map[string]lifetime
+
+	// contextFactories đánh dấu các binding đăng ký qua BindContext/SingletonContext.
+	contextFactories map[string]bool
+
+	// paramFactories đánh dấu các binding đăng ký qua BindWith.
+	paramFactories map[string]bool
+
+	// disposables là các instance (Instance, singleton) cần giải phóng khi Close, theo thứ tự tạo.
+	disposables []disposable
+
+	// sources chứa stack của lời gọi đăng ký binding hoặc instance, theo key.
+	sources map[string]callSite
+
+	// aliasSources chứa stack của lời gọi Alias, theo alias.
+	aliasSources map[string]callSite
+
+	// parent là container cha, nil với container gốc.
+	parent *container
+
 	// mu bảo vệ mọi thao tác concurrent trên container.
 	mu sync.RWMutex
+
+	// extendVersion tăng mỗi lần Extend đăng ký decorator; Instance decorate lại nếu nó đổi trong lúc decorator chạy.
+	extendVersion uint64
+
+	// decoratedVersions ghi nhận instance của mỗi key đã được decorate theo phiên bản nào của danh sách decorator,
+	// để Extend chỉ áp dụng decorator đăng ký sau đó.
+	decoratedVersions map[string]uint64
 }
 
 // New khởi tạo một DI container rỗng, sẵn sàng cho việc đăng ký binding, instance, alias.
 // Trả về: Container interface với hiện thực mặc định.
 func New() Container {
 	return &container{
-		bindings:  make(map[string]BindingFunc),
-		instances: make(map[string]interface{}),
-		aliases:   make(map[string]string),
+		bindings:          make(map[string]BindingFunc),
+		instances:         make(map[string]interface{}),
+		aliases:           make(map[string]string),
+		building:          make(map[string]*pendingInstance),
+		tags:              make(map[string][]string),
+		lifetimes:         make(map[string]lifetime),
+		contextFactories:  make(map[string]bool),
+		paramFactories:    make(map[string]bool),
+		decoratedVersions: make(map[string]uint64),
+		resolved:          make(map[string]bool),
+		dependents:        make(map[string]map[string]struct{}),
+		sources:           make(map[string]callSite),
+		aliasSources:      make(map[string]callSite),
 	}
 }
 
@@ -94,28 +274,67 @@ func New() Container {
 //
 //   - Mục đích: Cho phép đăng ký cách khởi tạo một dependency động, phục vụ cho việc resolve về sau.
 //   - Logic: Lưu factory function vào map bindings, override nếu đã tồn tại.
+//     Nếu abstract là alias, binding được đăng ký cho key gốc (như BindIf), để Make qua alias dùng binding mới.
+//     Nếu abstract đã từng được resolve, các callback Rebinding được gọi với instance mới.
 //   - Tham số:
 //   - abstract: string — tên logic của dependency (thường là interface hoặc service name).
 //   - concrete: BindingFunc — factory function nhận container, trả về instance.
 //   - Trả về: Không trả về.
 //   - Lỗi: Nếu abstract rỗng hoặc nil, panic hoặc silent error (tùy implement).
 func (c *container) Bind(abstract string, concrete BindingFunc) {
+	c.register(c.canonical(abstract), concrete, lifetimeTransient)
+}
+
+// register lưu binding cùng vòng đời của nó.
+//
+// Instance đã cache (hoặc đang khởi tạo) của singleton cũ bị bỏ để binding mới có hiệu lực.
+// Nếu abstract đã từng được resolve, các callback Rebinding được gọi với instance mới; instance đăng ký qua Instance
+// vẫn được ưu tiên hơn binding mới, nên khi nó còn che binding thì Make không đổi và callback không được gọi.
+func (c *container) register(abstract string, concrete BindingFunc, lt lifetime) {
+	source := callerSite()
+
 	c.mu.Lock()
-	defer c.mu.Unlock()
+
+	if _, exists := c.bindings[abstract]; exists && c.lifetimes[abstract] == lifetimeSingleton {
+		delete(c.instances, abstract)
+		delete(c.building, abstract)
+	}
 
 	c.bindings[abstract] = concrete
+	c.sources[abstract] = source
+	delete(c.contextFactories, abstract)
+	delete(c.paramFactories, abstract)
+	if lt == lifetimeTransient {
+		delete(c.lifetimes, abstract)
+	} else {
+		c.lifetimes[abstract] = lt
+	}
+
+	_, shadowed := c.instances[abstract]
+	resolved := c.resolved[abstract] && !shadowed
+	c.mu.Unlock()
+
+	if resolved {
+		c.rebound(abstract)
+	}
 }
 
 // BindIf đăng ký binding chỉ khi chưa tồn tại.
 //
 //   - Mục đích: Đảm bảo không override binding đã có, dùng cho module mở rộng.
-//   - Logic: Kiểm tra tồn tại, chỉ bind nếu chưa có.
+//   - Logic: Kiểm tra tồn tại, chỉ bind nếu chưa có. Nếu abstract là alias, binding của key gốc được kiểm tra
+//     và binding mới được đăng ký cho key gốc.
 //   - Tham số: như Bind.
 //   - Trả về: true nếu đăng ký thành công, false nếu đã tồn tại.
 func (c *container) BindIf(abstract string, concrete BindingFunc) bool {
-	c.mu.RLock()
-	_, exists := c.bindings[abstract]
-	c.mu.RUnlock()
+	abstract = c.canonical(abstract)
+
+	exists := false
+	for current := c; current != nil && !exists; current = current.parent {
+		current.mu.RLock()
+		_, exists = current.bindings[abstract]
+		current.mu.RUnlock()
+	}
 
 	if !exists {
 		c.Bind(abstract, concrete)
@@ -125,70 +344,341 @@ func (c *container) BindIf(abstract string, concrete BindingFunc) bool {
 	return false
 }
 
-// singletonResolver là hàm nội bộ xử lý logic của singleton để dễ test
+// pendingInstance theo dõi một lượt khởi tạo instance dùng chung (singleton hoặc scoped) cho một key.
+//
+// Các goroutine khác resolve cùng key sẽ chờ trên done thay vì chờ lock toàn cục của container.
+type pendingInstance struct {
+	// done được đóng khi factory đã chạy xong (thành công, lỗi hoặc panic).
+	done chan struct{}
+
+	// instance là kết quả của factory, chỉ hợp lệ khi err == nil.
+	instance interface{}
+
+	// err là lỗi của factory hoặc lỗi mô tả panic.
+	err error
+
+	// extenders là các decorator đăng ký qua Extend trong lúc singleton đang khởi tạo,
+	// được áp dụng trước khi instance được cache.
+	extenders []ExtenderFunc
+}
+
+// result trả về kết quả khởi tạo dưới dạng giá trị của BindingFunc.
+func (p *pendingInstance) result() interface{} {
+	if p.err != nil {
+		return &bindingFailure{err: p.err}
+	}
+	return p.instance
+}
+
+// fill gọi concrete khi không giữ lock và ghi kết quả vào p.
+//
+// finish được gọi trước khi đóng done để cập nhật cache. Nếu concrete panic, p.err mô tả panic
+// (các goroutine đang chờ nhận lỗi) và panic được truyền tiếp cho goroutine đang khởi tạo.
+func (p *pendingInstance) fill(abstract string, concrete func() interface{}, finish func()) {
+	defer func() {
+		recovered := recover()
+		if recovered != nil {
+			if err, ok := recovered.(*ResolutionError); ok {
+				p.err = err
+			} else {
+				p.err = &PanicError{Key: abstract, Value: recovered}
+			}
+		}
+
+		finish()
+		close(p.done)
+
+		if recovered != nil {
+			panic(recovered)
+		}
+	}()
+
+	instance := concrete()
+	if failure, failed := instance.(*bindingFailure); failed {
+		p.err = failure.err
+		return
+	}
+	p.instance = instance
+}
+
+// decorate áp dụng extenders cho instance của p; panic của decorator được ghi nhận thành p.err.
+func (p *pendingInstance) decorate(abstract string, extenders []ExtenderFunc, view Container) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			p.err = &PanicError{Key: abstract, Value: recovered}
+		}
+	}()
+
+	p.instance = applyExtenders(extenders, p.instance, view)
+}
+
+// singletonResolver là hàm nội bộ xử lý logic của singleton để dễ test.
+//
+// Việc khởi tạo được bảo vệ theo từng key: chỉ một goroutine gọi concrete cho mỗi key,
+// các goroutine khác chỉ chờ trên key đó. Lock toàn cục c.mu không bao giờ được giữ khi gọi concrete,
+// nên factory có thể tự do resolve các dependency khác (kể cả singleton khác).
 func (c *container) singletonResolver(abstract string, concrete BindingFunc) interface{} {
 	c.mu.RLock()
 	instance, exists := c.instances[abstract]
 	c.mu.RUnlock()
 
-	if exists && instance != nil {
+	if exists {
 		return instance
 	}
 
 	c.mu.Lock()
-	defer c.mu.Unlock()
 
 	// Double-check pattern: check again after acquiring write lock
 	if instance, exists := c.instances[abstract]; exists {
+		c.mu.Unlock()
 		return instance
 	}
 
-	instance = concrete(c)
-	c.instances[abstract] = instance
+	// Đã có goroutine khác đang khởi tạo key này: chờ kết quả của nó
+	if pending, building := c.building[abstract]; building {
+		c.mu.Unlock()
+		<-pending.done
+		return pending.result()
+	}
 
-	return instance
+	pending := &pendingInstance{done: make(chan struct{})}
+	c.building[abstract] = pending
+	c.mu.Unlock()
+
+	c.constructSingleton(abstract, pending, concrete)
+
+	return pending.result()
+}
+
+// constructSingleton gọi factory của singleton khi không giữ lock, sau đó cache kết quả thành công.
+//
+// Factory lỗi không được cache. Nếu factory panic, các goroutine đang chờ nhận lỗi và panic được truyền tiếp.
+// Kết quả bị bỏ qua nếu container đã Reset trong lúc khởi tạo.
+func (c *container) constructSingleton(abstract string, pending *pendingInstance, concrete BindingFunc) {
+	pending.fill(abstract, func() interface{} {
+		return concrete(c)
+	}, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		// Decorator đăng ký qua Extend trong lúc khởi tạo chạy khi không giữ lock, trước khi instance được cache
+		for c.building[abstract] == pending && pending.err == nil && len(pending.extenders) > 0 {
+			extenders := pending.extenders
+			pending.extenders = nil
+
+			c.mu.Unlock()
+			pending.decorate(abstract, extenders, c)
+			c.mu.Lock()
+		}
+
+		if c.building[abstract] == pending {
+			delete(c.building, abstract)
+			if _, exists := c.instances[abstract]; !exists && pending.err == nil {
+				c.instances[abstract] = pending.instance
+				c.decoratedVersions[abstract] = c.extendVersion
+				c.disposables = trackDisposable(c.disposables, abstract, pending.instance, false)
+			}
+		}
+	})
 }
 
 // Singleton đăng ký binding singleton (chỉ tạo một instance duy nhất).
 //
 //   - Mục đích: Đảm bảo dependency chỉ được khởi tạo một lần duy nhất trong suốt vòng đời container.
 //   - Logic: Factory function được wrap lại, lưu instance vào map instances khi lần đầu resolve.
+//     Việc khởi tạo được đồng bộ theo từng key nên factory có thể resolve các singleton khác.
+//     Extender và hook Resolving/AfterResolving được áp dụng một lần, trước khi instance được cache.
 //   - Tham số: như Bind.
 //   - Trả về: Không trả về.
 func (c *container) Singleton(abstract string, concrete BindingFunc) {
-	c.Bind(abstract, func(container Container) interface{} {
-		return c.singletonResolver(abstract, concrete)
-	})
+	abstract = c.canonical(abstract)
+	c.register(abstract, func(container Container) interface{} {
+		build := func(Container) interface{} {
+			view := c.sharedView(container)
+			return c.complete(abstract, concrete(view), view)
+		}
+
+		// Validate khởi tạo singleton trong cache tạm của nó, không đụng tới cache của container
+		if v := validationOf(container); v != nil {
+			return c.validationSingleton(v, abstract, build)
+		}
+		return c.singletonResolver(abstract, build)
+	}, lifetimeSingleton)
+}
+
+// BindE đăng ký một binding với factory có thể trả về lỗi.
+//
+//   - Mục đích: Cho phép factory báo lỗi (ví dụ: không mở được kết nối DB) thay vì panic hoặc trả về nil.
+//   - Logic: Như Bind; lỗi của factory được Make trả về cho caller, bọc trong *ResolutionError.
+//   - Tham số:
+//   - abstract: string — tên logic của dependency.
+//   - concrete: BindingFuncE — factory function trả về instance hoặc lỗi.
+func (c *container) BindE(abstract string, concrete BindingFuncE) {
+	c.Bind(abstract, concrete.binding())
+}
+
+// BindIfE như BindIf nhưng nhận factory có thể trả về lỗi.
+//
+//   - Trả về: true nếu đăng ký thành công, false nếu đã tồn tại.
+func (c *container) BindIfE(abstract string, concrete BindingFuncE) bool {
+	return c.BindIf(abstract, concrete.binding())
+}
+
+// SingletonE đăng ký singleton với factory có thể trả về lỗi.
+//
+//   - Logic: Như Singleton; nếu factory trả về lỗi, instance không được cache (kể cả nil)
+//     và lần resolve sau sẽ gọi lại factory.
+func (c *container) SingletonE(abstract string, concrete BindingFuncE) {
+	c.Singleton(abstract, concrete.binding())
+}
+
+// BindContext đăng ký một binding với factory nhận context.Context.
+//
+//   - Mục đích: Cho phép factory tôn trọng deadline/cancellation và đọc giá trị request-scoped.
+//   - Logic: Factory nhận ctx của MakeContext/CallContext (context.Background() với Make/Call);
+//     lỗi trả về được Make truyền lại cho caller như BindE.
+//   - Tham số:
+//   - abstract: string — tên logic của dependency.
+//   - concrete: BindingFuncContext — factory nhận ctx và container.
+func (c *container) BindContext(abstract string, concrete BindingFuncContext) {
+	c.Bind(abstract, concrete.binding())
+	c.markContextFactory(abstract)
+}
+
+// SingletonContext đăng ký singleton với factory nhận context.Context.
+//
+//   - Logic: Factory nhận ctx của lượt resolve đầu tiên kích hoạt việc khởi tạo.
+//     Nếu factory trả về lỗi (ví dụ ctx.Err()), instance không được cache.
+func (c *container) SingletonContext(abstract string, concrete BindingFuncContext) {
+	c.Singleton(abstract, concrete.binding())
+	c.markContextFactory(abstract)
+}
+
+// markContextFactory đánh dấu binding của abstract có factory nhận context.Context.
+func (c *container) markContextFactory(abstract string) {
+	abstract = c.canonical(abstract)
+
+	c.mu.Lock()
+	c.contextFactories[abstract] = true
+	c.mu.Unlock()
+}
+
+// isContextFactory kiểm tra abstract (sau khi resolve alias) sẽ được khởi tạo bởi factory nhận context.Context,
+// tức binding BindContext/SingletonContext chưa có instance đã cache.
+func (c *container) isContextFactory(abstract string) bool {
+	return c.factoryMarked(abstract, func(current *container, key string) bool { return current.contextFactories[key] })
+}
+
+// isParamFactory kiểm tra abstract (sau khi resolve alias) sẽ được khởi tạo bởi factory đăng ký qua BindWith.
+func (c *container) isParamFactory(abstract string) bool {
+	return c.factoryMarked(abstract, func(current *container, key string) bool { return current.paramFactories[key] })
+}
+
+// factoryMarked tìm đăng ký của abstract (sau khi resolve alias) từ c lên container cha và trả về marked
+// của container giữ đăng ký đó; false nếu đăng ký là instance hoặc không tìm thấy.
+func (c *container) factoryMarked(abstract string, marked func(current *container, key string) bool) bool {
+	abstract = c.canonical(abstract)
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		_, isInstance := current.instances[abstract]
+		_, isBinding := current.bindings[abstract]
+		isMarked := marked(current, abstract)
+		current.mu.RUnlock()
+
+		if isInstance || isBinding {
+			return isMarked && !isInstance
+		}
+	}
+	return false
 }
 
 // Instance đăng ký một instance đã khởi tạo sẵn.
 //
 //   - Mục đích: Cho phép inject các giá trị đã tồn tại (config, logger, ...), không cần factory.
-//   - Logic: Lưu trực tiếp vào map instances.
+//   - Logic: Lưu trực tiếp vào map instances, sau khi áp dụng các extender đã đăng ký cho abstract.
+//     Nếu abstract là alias, instance được lưu cho key gốc như Bind.
+//     Nếu abstract đã từng được resolve, các callback Rebinding được gọi với instance mới.
 //   - Tham số:
 //   - abstract: string — tên logic.
 //   - instance: interface{} — giá trị đã khởi tạo.
 //   - Trả về: Không trả về.
 func (c *container) Instance(abstract string, instance interface{}) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	abstract = c.canonical(abstract)
+	instance, resolved := c.storeInstance(abstract, instance, callerSite())
+	if resolved {
+		c.fireRebinding(abstract, instance)
+	}
+}
 
-	c.instances[abstract] = instance
+// storeInstance decorate instance rồi lưu nó dưới abstract.
+//
+// Decorator chạy khi không giữ lock; nếu Extend đăng ký decorator mới trong lúc đó, instance được decorate lại
+// để decorator mới không bị mất. Trả về instance đã decorate và abstract đã từng được resolve hay chưa.
+func (c *container) storeInstance(abstract string, instance interface{}, source callSite) (interface{}, bool) {
+	for {
+		c.mu.RLock()
+		version := c.extendVersion
+		c.mu.RUnlock()
+
+		decorated := c.decorate(abstract, instance, c)
+
+		c.mu.Lock()
+		if c.extendVersion != version {
+			c.mu.Unlock()
+			continue
+		}
+		c.untrackDisposable(abstract)
+		c.instances[abstract] = decorated
+		c.decoratedVersions[abstract] = version
+		c.sources[abstract] = source
+		c.disposables = trackDisposable(c.disposables, abstract, decorated, true)
+		resolved := c.resolved[abstract]
+		c.mu.Unlock()
+
+		return decorated, resolved
+	}
 }
 
 // Alias đăng ký một alias cho abstract type.
 //
 //   - Mục đích: Cho phép truy cập dependency qua nhiều tên khác nhau (ví dụ: "log" và "logger").
 //   - Logic: Lưu alias vào map aliases, resolve alias sẽ trả về instance của abstract gốc.
+//     abstract có thể là một alias khác: chuỗi alias được resolve bắc cầu ("db" -> "database" -> "database.mysql").
 //   - Tham số:
-//   - abstract: string — tên gốc.
+//   - abstract: string — tên gốc (hoặc alias khác).
 //   - alias: string — tên alias.
 //   - Trả về: Không trả về.
+//   - Lỗi: panic với *AliasError (wrap ErrAliasCycle) nếu alias trỏ tới chính nó hoặc tạo thành vòng;
+//     alias bị từ chối không được lưu.
 func (c *container) Alias(abstract, alias string) {
+	if err := c.checkAlias(abstract, alias); err != nil {
+		panic(err)
+	}
+
+	source := callerSite()
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	c.aliases[alias] = abstract
+	c.aliasSources[alias] = source
+}
+
+// checkAlias trả về *AliasError nếu alias trỏ tới abstract tạo thành vòng (kể cả trỏ tới chính nó), nil nếu hợp lệ.
+func (c *container) checkAlias(abstract, alias string) error {
+	chain := []string{alias, abstract}
+	seen := map[string]bool{abstract: true}
+	for current := abstract; current != alias; {
+		target, exists := c.aliasOf(current)
+		if !exists || seen[target] {
+			return nil
+		}
+		seen[target] = true
+		chain = append(chain, target)
+		current = target
+	}
+
+	return &AliasError{Alias: alias, Abstract: abstract, Chain: chain}
 }
 
 // Make resolve một dependency từ container.
@@ -199,7 +689,8 @@ func (c *container) Alias(abstract, alias string) {
 //   - abstract: string — tên logic.
 //   - Trả về:
 //   - interface{}: instance đã resolve.
-//   - error: nếu không tìm thấy hoặc binding lỗi.
+//   - error: *ResolutionError nếu không tìm thấy (ErrNotFound), binding lỗi hoặc phát hiện circular dependency
+//     (ErrCircularDependency); lỗi chứa chuỗi resolve từ key cấp cao nhất tới key gặp lỗi.
 func (c *container) Make(abstract string) (interface{}, error) {
 	return c.make(abstract)
 }
@@ -218,50 +709,239 @@ func (c *container) MustMake(abstract string) interface{} {
 	return instance
 }
 
+// MakeContext resolve một dependency với context.
+//
+//   - Mục đích: Cho phép factory tôn trọng deadline, cancellation và các giá trị request-scoped (tenant ID, trace span).
+//   - Logic: ctx được truyền tới các factory đăng ký qua BindContext/SingletonContext và tới mọi resolve lồng nhau.
+//     Nếu ctx bị huỷ trong khi factory BindContext/SingletonContext của abstract (hoặc của một dependency lồng nhau)
+//     đang chặn, MakeContext trả về ngay với lỗi wrap ctx.Err(); factory tiếp tục chạy nền và kết quả (kể cả panic)
+//     của nó bị bỏ qua (singleton thành công vẫn được cache).
+//     Với binding khác, ctx.Err() được kiểm tra trước và sau khi khởi tạo.
+//   - Tham số:
+//   - ctx: context.Context — context của lượt resolve.
+//   - abstract: string — tên logic.
+//   - Trả về: như Make; lỗi wrap ctx.Err() nếu ctx bị huỷ hoặc hết hạn.
+func (c *container) MakeContext(ctx context.Context, abstract string) (interface{}, error) {
+	return c.resolveContext(newResolveState(ctx), abstract)
+}
+
+// MakeWith resolve một dependency với tham số runtime.
+//
+//   - Mục đích: Tạo service cần đầu vào theo từng lần gọi (tenant ID, base URL, ...).
+//   - Logic:
+//   - Factory đăng ký qua BindWith nhận params; params được truyền qua mọi resolve lồng nhau của binding transient.
+//   - Tham số có tên trùng với key (hoặc alias) của một dependency lồng nhau thay thế dependency đó.
+//   - Singleton, binding scoped và instance không nhận tham số: MakeWith trả về lỗi wrap ErrParamsNotAllowed.
+//     Singleton lồng nhau được khởi tạo không có params để không cache instance phụ thuộc vào một lượt gọi.
+//   - Tham số:
+//   - abstract: string — tên logic.
+//   - params: Params — tham số runtime theo tên; rỗng tương đương Make.
+//   - Trả về: như Make.
+func (c *container) MakeWith(abstract string, params Params) (interface{}, error) {
+	return c.resolve(newResolveState(context.Background()).withParams(params), abstract)
+}
+
+// BindWith đăng ký binding với factory nhận params của MakeWith.
+//
+//   - Logic: Như BindE; factory nhận params của MakeWith (nil khi resolve qua Make).
+//   - Tham số:
+//   - abstract: string — tên logic của dependency.
+//   - concrete: BindingFuncWith — factory nhận container và params.
+func (c *container) BindWith(abstract string, concrete BindingFuncWith) {
+	abstract = c.canonical(abstract)
+	c.Bind(abstract, concrete.binding())
+
+	c.mu.Lock()
+	c.paramFactories[abstract] = true
+	c.mu.Unlock()
+}
+
 // make là hiện thực nội bộ của Make
 func (c *container) make(abstract string) (interface{}, error) {
-	c.mu.RLock()
+	return c.resolve(newResolveState(context.Background()), abstract)
+}
+
+// resolveContext resolve abstract và trả về lỗi wrap ctx.Err() khi state.ctx bị huỷ.
+//
+// Chỉ factory nhận context (BindContext, SingletonContext) được chạy trong goroutine riêng để trả về sớm
+// khi ctx bị huỷ trong lúc factory đang chặn, kể cả khi chúng là dependency lồng nhau (xem resolve);
+// các binding khác được resolve trực tiếp, ctx.Err() được kiểm tra trước (trong resolve) và sau khi khởi tạo.
+func (c *container) resolveContext(state resolveState, abstract string) (interface{}, error) {
+	done := state.ctx.Done()
+	if done == nil {
+		return c.resolve(state, abstract)
+	}
+
+	if !c.isContextFactory(abstract) {
+		if err := state.ctx.Err(); err != nil {
+			return nil, &ResolutionError{Key: abstract, Chain: appendChain(state.chain, abstract), Cause: err}
+		}
+
+		instance, err := c.resolve(state, abstract)
+		if err == nil {
+			if ctxErr := state.ctx.Err(); ctxErr != nil {
+				return nil, &ResolutionError{Key: abstract, Chain: appendChain(state.chain, abstract), Cause: ctxErr}
+			}
+		}
+		return instance, err
+	}
+
+	return awaitContext(state, abstract, func() (interface{}, error) { return c.resolve(state, abstract) })
+}
+
+// awaitContext chạy resolve trong goroutine riêng và trả về lỗi wrap ctx.Err() của abstract ngay khi state.ctx bị huỷ;
+// goroutine vẫn chạy tới khi factory trả về, panic của nó được ném lại ở goroutine gọi nếu ctx chưa bị huỷ.
+func awaitContext(state resolveState, abstract string, resolve func() (interface{}, error)) (interface{}, error) {
+	type outcome struct {
+		instance  interface{}
+		err       error
+		recovered interface{}
+	}
+
+	result := make(chan outcome, 1)
+	go func() {
+		var o outcome
+		defer func() {
+			o.recovered = recover()
+			result <- o
+		}()
+		o.instance, o.err = resolve()
+	}()
+
+	select {
+	case o := <-result:
+		if o.recovered != nil {
+			panic(o.recovered)
+		}
+		return o.instance, o.err
+	case <-state.ctx.Done():
+		return nil, &ResolutionError{Key: abstract, Chain: appendChain(state.chain, abstract), Cause: state.ctx.Err()}
+	}
+}
+
+// resolve resolve abstract trong chuỗi resolve state.chain.
+//
+// Trả về *ResolutionError với Cause là ErrCircularDependency nếu abstract đã nằm trong chain,
+// hoặc wrap ctx.Err() nếu context của lượt resolve đã bị huỷ trước khi gọi factory.
+func (c *container) resolve(state resolveState, abstract string) (interface{}, error) {
+	// Tham số của MakeWith trùng tên với dependency lồng nhau thay thế dependency đó
+	if value, overridden := state.param(abstract); overridden {
+		return value, nil
+	}
+
 	// Nếu có alias thì resolve alias trước
-	if alias, exists := c.aliases[abstract]; exists {
-		abstract = alias
+	abstract = c.canonical(abstract)
+
+	if value, overridden := state.param(abstract); overridden {
+		return value, nil
 	}
 
-	// Nếu đã có instance thì trả về luôn
-	if instance, exists := c.instances[abstract]; exists {
-		c.mu.RUnlock()
+	// Contextual binding của consumer (key đang được khởi tạo) được ưu tiên hơn binding thông thường
+	lt := lifetimeTransient
+	var owner *container
+	var instance interface{}
+	concrete, exists := c.consumerConcrete(state, abstract)
+	if !exists {
+		instance, concrete, lt, owner = c.lookup(abstract)
+		exists = owner != nil
+	}
+
+	if !exists {
+		return nil, &ResolutionError{Key: abstract, Chain: appendChain(state.chain, abstract), Cause: fmt.Errorf("%w for: %s", ErrNotFound, abstract)}
+	}
+
+	// Binding dùng chung (singleton, scoped, instance) không nhận tham số của MakeWith
+	if state.paramsTarget && len(state.params) > 0 && (concrete == nil || lt != lifetimeTransient) {
+		return nil, &ResolutionError{Key: abstract, Chain: appendChain(state.chain, abstract), Cause: fmt.Errorf("%w: %s", ErrParamsNotAllowed, abstract)}
+	}
+
+	// Ghi nhận consumer phụ thuộc abstract, dùng để bỏ cache singleton phụ thuộc khi Forget
+	if len(state.chain) > 0 && state.validation == nil {
+		c.recordDependency(state.chain[len(state.chain)-1], abstract)
+	}
+
+	// Nếu đã có instance thì trả về luôn, nếu không thì resolve từ binding
+	if concrete == nil {
+		if state.validation == nil {
+			owner.markResolved(abstract)
+		}
 		return instance, nil
 	}
 
-	// Resolve từ binding
-	concrete, exists := c.bindings[abstract]
-	c.mu.RUnlock()
+	// Chuỗi resolve chỉ được cấp phát khi factory thực sự được gọi
+	nested := state.with(abstract)
 
-	if !exists {
-		return nil, fmt.Errorf("bind not found for: %s", abstract)
+	for _, key := range state.chain {
+		if key == abstract {
+			return nil, &ResolutionError{Key: abstract, Chain: nested.chain, Cause: ErrCircularDependency}
+		}
+	}
+
+	if err := state.ctx.Err(); err != nil {
+		return nil, &ResolutionError{Key: abstract, Chain: nested.chain, Cause: err}
+	}
+
+	// Singleton và scoped tự hoàn tất instance trước khi cache; binding transient được hoàn tất mỗi lần resolve
+	if lt == lifetimeTransient {
+		concrete = c.completed(abstract, concrete)
+	}
+
+	var err error
+	if len(state.chain) > 0 && state.ctx.Done() != nil && c.isContextFactory(abstract) {
+		// Factory nhận context của dependency lồng nhau cũng trả về sớm khi ctx bị huỷ, như lời gọi cấp cao nhất
+		instance, err = awaitContext(state, abstract, func() (interface{}, error) { return c.build(nested, concrete) })
+	} else {
+		instance, err = c.build(nested, concrete)
+	}
+	if err == nil && owner != nil && state.validation == nil {
+		owner.markResolved(abstract)
 	}
 
-	return concrete(c), nil
+	return instance, err
+}
+
+// build gọi factory với resolver mang trạng thái resolve state.
+//
+// Lỗi của các lượt resolve lồng nhau (kể cả panic *ResolutionError từ MustMake) được trả về nguyên vẹn
+// để giữ chuỗi resolve đầy đủ; lỗi khác của factory được bọc trong *ResolutionError.
+func (c *container) build(state resolveState, concrete BindingFunc) (instance interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			resolutionErr, ok := r.(*ResolutionError)
+			if !ok {
+				panic(r)
+			}
+			instance, err = nil, resolutionErr
+		}
+	}()
+
+	instance = concrete(&resolver{container: c, state: state})
+	if failure, failed := instance.(*bindingFailure); failed {
+		var resolutionErr *ResolutionError
+		if errors.As(failure.err, &resolutionErr) {
+			return nil, failure.err
+		}
+		return nil, &ResolutionError{Key: state.chain[len(state.chain)-1], Chain: state.chain, Cause: failure.err}
+	}
+
+	return instance, nil
 }
 
 // Bound kiểm tra một abstract đã được đăng ký binding/instance/alias chưa.
 //
 //   - Mục đích: Hỗ trợ kiểm tra trạng thái container, phục vụ cho module động.
+//   - Logic: Alias được resolve bắc cầu; alias chỉ được coi là đã đăng ký khi key gốc của nó có binding/instance.
 //   - Tham số: abstract: string.
 //   - Trả về: true nếu đã đăng ký, false nếu chưa.
 func (c *container) Bound(abstract string) bool {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	_, boundAsBinding := c.bindings[abstract]
-	_, boundAsInstance := c.instances[abstract]
-	_, boundAsAlias := c.aliases[abstract]
-
-	return boundAsBinding || boundAsInstance || boundAsAlias
+	_, _, _, owner := c.lookup(c.canonical(abstract))
+	return owner != nil
 }
 
-// Reset xóa toàn bộ binding, instance, alias khỏi container.
+// Reset xóa toàn bộ binding, instance, alias, tag, contextual binding, extender, hook, callback Rebinding khỏi container.
 //
 //   - Mục đích: Làm sạch container, thường dùng cho test hoặc reload.
+//   - Lưu ý: Reset không giải phóng instance; gọi Close trước Reset để đóng các tài nguyên đang giữ.
 //   - Trả về: Không trả về.
 func (c *container) Reset() {
 	c.mu.Lock()
@@ -270,56 +950,94 @@ func (c *container) Reset() {
 	c.bindings = make(map[string]BindingFunc)
 	c.instances = make(map[string]interface{})
 	c.aliases = make(map[string]string)
+	c.building = make(map[string]*pendingInstance)
+	c.tags = make(map[string][]string)
+	c.contextual = nil
+	c.extenders = nil
+	c.hooks = nil
+	c.rebindings = nil
+	c.resolved = make(map[string]bool)
+	c.dependents = make(map[string]map[string]struct{})
+	c.lifetimes = make(map[string]lifetime)
+	c.contextFactories = make(map[string]bool)
+	c.paramFactories = make(map[string]bool)
+	c.decoratedVersions = make(map[string]uint64)
+	c.disposables = nil
+	c.sources = make(map[string]callSite)
+	c.aliasSources = make(map[string]callSite)
 }
 
 // Call gọi một hàm và tự động resolve các dependency qua reflection.
 //
 //   - Mục đích: Tự động inject các dependency vào callback function, hỗ trợ DI cho hàm tự do.
 //   - Logic: Phân tích các tham số của callback, resolve từ container hoặc lấy từ additionalParams.
+//     Tham số được resolve theo key KeyOf của kiểu nếu đã đăng ký (Provide, BindConstructor),
+//     nếu không theo tên kiểu ngắn (reflect.Type.String()).
+//     Tham số kiểu Container và context.Context nhận container và ctx hiện tại, kiểu Application
+//     được resolve từ key "app" nếu chưa đăng ký theo kiểu. Tham số variadic nhận các additionalParams
+//     còn lại khớp kiểu phần tử. Error trả về của callback nằm trong kết quả (dùng Invoke để nhận nó như lỗi).
 //   - Tham số:
 //   - callback: interface{} — function cần gọi.
 //   - additionalParams: ...interface{} — các tham số bổ sung (ưu tiên inject).
 //   - Trả về:
 //   - []interface{}: kết quả trả về của callback.
 //   - error: nếu không resolve được tham số hoặc callback không hợp lệ.
-//   - Lỗi: Trả về error nếu callback không phải function, hoặc không resolve được dependency.
+//   - Lỗi: ErrNotAFunction nếu callback không phải function; *ParameterError (wrap lỗi resolve gốc
+//     hoặc *TypeMismatchError) nếu không resolve được dependency.
 func (c *container) Call(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	return c.call(newResolveState(context.Background()), callback, additionalParams)
+}
+
+// CallContext gọi một hàm và tự động resolve các dependency với context.
+//
+//   - Mục đích: Như Call, nhưng ctx được truyền tới các factory khi resolve tham số.
+//   - Logic: Việc resolve tham số trả về sớm nếu ctx bị huỷ; bản thân callback được gọi đồng bộ.
+//   - Tham số:
+//   - ctx: context.Context — context của lượt resolve.
+//   - callback, additionalParams: như Call.
+//   - Trả về: như Call; lỗi wrap ctx.Err() nếu ctx bị huỷ trước khi resolve xong tham số.
+func (c *container) CallContext(ctx context.Context, callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	return c.call(newResolveState(ctx), callback, additionalParams)
+}
+
+// call là hiện thực nội bộ của Call, các tham số được resolve trong trạng thái resolve state.
+func (c *container) call(state resolveState, callback interface{}, additionalParams []interface{}) ([]interface{}, error) {
 	callbackType := reflect.TypeOf(callback)
-	if callbackType.Kind() != reflect.Func {
-		return nil, fmt.Errorf("callback must be a function")
-	}
-
-	var args []reflect.Value
-	for i := 0; i < callbackType.NumIn(); i++ {
-		paramType := callbackType.In(i)
-
-		// Kiểm tra xem có trong additionalParams không
-		found := false
-		for _, param := range additionalParams {
-			paramValue := reflect.ValueOf(param)
-			if paramValue.Type().AssignableTo(paramType) {
-				args = append(args, paramValue)
-				found = true
-				break
-			}
-		}
+	if callbackType == nil || callbackType.Kind() != reflect.Func {
+		return nil, ErrNotAFunction
+	}
 
-		if !found {
-			// Thử resolve từ container
-			typeName := paramType.String()
-			instance, err := c.make(typeName)
-			if err != nil {
-				return nil, fmt.Errorf("cannot resolve parameter %s: %v", typeName, err)
-			}
-			args = append(args, reflect.ValueOf(instance))
-		}
+	return c.callPlan(state, planFor(callbackType), nil, reflect.ValueOf(callback), additionalParams)
+}
+
+// callPlan gọi fn theo plan đã biên dịch, các tham số được resolve trong trạng thái resolve state.
+//
+// abstracts là key đã chọn sẵn cho từng tham số cố định (xem invoker); nil hoặc "" nghĩa là chọn khi gọi.
+func (c *container) callPlan(state resolveState, plan *callPlan, abstracts []string, fn reflect.Value, additionalParams []interface{}) ([]interface{}, error) {
+	// Đối số của callback ít tham số nằm trên stack thay vì cấp phát mỗi lần gọi
+	var argsBuf [8]reflect.Value
+	args, err := c.callArgs(state, plan, abstracts, argsBuf[:0], additionalParams)
+	if err != nil {
+		return nil, err
+	}
+
+	returnValues := fn.Call(args)
+	if len(returnValues) == 0 {
+		return nil, nil
 	}
 
-	returnValues := reflect.ValueOf(callback).Call(args)
-	var result []interface{}
-	for _, v := range returnValues {
-		result = append(result, v.Interface())
+	result := make([]interface{}, len(returnValues))
+	for i, v := range returnValues {
+		result[i] = v.Interface()
 	}
 
 	return result, nil
 }
+
+// resolveParam resolve tham số của Call; lời gọi cấp cao nhất trả về sớm khi ctx bị huỷ.
+func (c *container) resolveParam(state resolveState, abstract string) (interface{}, error) {
+	if len(state.chain) == 0 {
+		return c.resolveContext(state, abstract)
+	}
+	return c.resolve(state, abstract)
+}
@@ -2,10 +2,13 @@
 package di
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 // MockService là một struct dùng cho mục đích test container
@@ -1249,7 +1252,7 @@ func TestSingletonResolverFirstEarlyReturn(t *testing.T) {
 	}
 }
 
-// TestSingletonResolverNilInstance tests the nil check in singletonResolver's first condition
+// TestSingletonResolverNilInstance tests singletonResolver when the cached instance is nil
 // We call singletonResolver directly to test the path where exists=true but instance=nil
 func TestSingletonResolverNilInstance(t *testing.T) {
 	cont := New()
@@ -1271,15 +1274,455 @@ func TestSingletonResolverNilInstance(t *testing.T) {
 	// Call singletonResolver directly to test the nil check
 	result := containerImpl.singletonResolver("service", factory)
 
-	// The current implementation has a bug: it returns nil from the double-check
-	// even though the first check correctly skips nil
-	// This test verifies the current behavior (which is buggy)
+	// A key present in instances counts as already built, even when the instance is nil
 	if result != nil {
-		t.Errorf("Expected nil due to bug in double-check, got %v", result)
+		t.Errorf("Expected the registered nil instance, got %v", result)
 	}
 
-	// Factory should NOT have been called due to the double-check bug
+	// Factory should NOT have been called because the key is already cached
 	if factoryCalled {
-		t.Error("Factory should not have been called due to double-check bug")
+		t.Error("Factory should not have been called for a cached nil instance")
+	}
+}
+
+// TestSingletonResolvesOtherSingleton kiểm tra factory singleton có thể resolve singleton khác mà không deadlock
+func TestSingletonResolvesOtherSingleton(t *testing.T) {
+	container := New()
+
+	container.Singleton("config", func(c Container) interface{} {
+		return &MockDependencyA{Value: "config"}
+	})
+	container.Singleton("repository", func(c Container) interface{} {
+		return &MockDependencyB{DependencyA: c.MustMake("config").(*MockDependencyA)}
+	})
+	container.Singleton("service", func(c Container) interface{} {
+		return &MockDependencyC{
+			DependencyA: c.MustMake("config").(*MockDependencyA),
+			DependencyB: c.MustMake("repository").(*MockDependencyB),
+		}
+	})
+
+	done := make(chan interface{})
+	go func() {
+		done <- container.MustMake("service")
+	}()
+
+	select {
+	case result := <-done:
+		service := result.(*MockDependencyC)
+		if service.DependencyB.DependencyA != service.DependencyA {
+			t.Error("Singleton lồng nhau không chia sẻ cùng instance config")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Singleton resolve singleton khác bị deadlock")
+	}
+}
+
+// TestSingletonPerKeyLocking kiểm tra singleton đang khởi tạo chỉ chặn các lời gọi cùng key
+func TestSingletonPerKeyLocking(t *testing.T) {
+	container := New()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	calls := 0
+
+	container.Singleton("slow", func(c Container) interface{} {
+		calls++
+		close(started)
+		<-release
+		return NewMockService("slow")
+	})
+	container.Instance("fast", "fast-value")
+
+	results := make(chan interface{}, 2)
+	go func() { results <- container.MustMake("slow") }()
+	<-started
+	go func() { results <- container.MustMake("slow") }()
+
+	// Trong khi "slow" đang khởi tạo, các key khác và việc đăng ký không bị chặn
+	if value, err := container.Make("fast"); err != nil || value != "fast-value" {
+		t.Errorf("Make() key khác bị ảnh hưởng khi singleton đang khởi tạo: %v, %v", value, err)
+	}
+	container.Bind("late", func(c Container) interface{} { return "late" })
+
+	close(release)
+	first, second := <-results, <-results
+
+	if first != second {
+		t.Error("Các goroutine chờ cùng key nhận instance khác nhau")
+	}
+	if calls != 1 {
+		t.Errorf("Factory singleton được gọi %d lần, expected 1", calls)
+	}
+}
+
+// TestSingletonPanicReleasesWaiters kiểm tra factory panic không giữ key ở trạng thái đang khởi tạo
+func TestSingletonPanicReleasesWaiters(t *testing.T) {
+	cont := New()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	calls := 0
+
+	cont.Singleton("flaky", func(c Container) interface{} {
+		calls++
+		if calls == 1 {
+			close(started)
+			<-release
+			panic("first attempt failed")
+		}
+		return NewMockService("recovered")
+	})
+
+	panicked := make(chan interface{})
+	go func() {
+		defer func() { panicked <- recover() }()
+		_, _ = cont.Make("flaky")
+	}()
+	<-started
+
+	waiterErr := make(chan error)
+	go func() {
+		_, err := cont.Make("flaky")
+		waiterErr <- err
+	}()
+
+	// Chờ goroutine thứ hai thực sự đợi trên key trước khi cho factory panic
+	for {
+		impl := cont.(*container)
+		impl.mu.RLock()
+		pending := impl.building["flaky"]
+		impl.mu.RUnlock()
+		if pending != nil {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if r := <-panicked; r != "first attempt failed" {
+		t.Errorf("Goroutine khởi tạo nên nhận lại panic của factory, got: %v", r)
+	}
+	if err := <-waiterErr; err == nil {
+		t.Error("Goroutine đang chờ nên nhận lỗi khi factory panic")
+	}
+
+	// Lần resolve tiếp theo khởi tạo lại thay vì cache kết quả lỗi
+	service, err := cont.Make("flaky")
+	if err != nil || service.(*MockService).ID != "recovered" {
+		t.Errorf("Singleton không được khởi tạo lại sau panic: %v, %v", service, err)
+	}
+}
+
+// TestBindE kiểm tra binding với factory trả về lỗi
+func TestBindE(t *testing.T) {
+	container := New()
+	errDial := errors.New("dial failed")
+
+	container.BindE("db", func(c Container) (interface{}, error) {
+		return nil, errDial
+	})
+
+	_, err := container.Make("db")
+	if !errors.Is(err, errDial) {
+		t.Errorf("Make() nên trả về lỗi của factory, got: %v", err)
+	}
+
+	// MustMake panic với lỗi của factory
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustMake() nên panic khi factory trả về lỗi")
+			}
+		}()
+		container.MustMake("db")
+	}()
+
+	// BindIfE không override binding đã có
+	if container.BindIfE("db", func(c Container) (interface{}, error) { return "ok", nil }) {
+		t.Error("BindIfE() nên trả về false khi binding đã tồn tại")
+	}
+	if !container.BindIfE("cache", func(c Container) (interface{}, error) { return "cache", nil }) {
+		t.Error("BindIfE() nên trả về true khi binding lần đầu")
+	}
+	if value, err := container.Make("cache"); err != nil || value != "cache" {
+		t.Errorf("BindIfE() không đăng ký đúng binding: %v, %v", value, err)
+	}
+}
+
+// TestSingletonE kiểm tra singleton lỗi không được cache như instance nil
+func TestSingletonE(t *testing.T) {
+	cont := New()
+
+	calls := 0
+	cont.SingletonE("db", func(c Container) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("not ready")
+		}
+		return NewMockService("db"), nil
+	})
+
+	if _, err := cont.Make("db"); err == nil {
+		t.Fatal("Make() nên trả về lỗi ở lần khởi tạo đầu")
+	}
+
+	if _, cached := cont.(*container).instances["db"]; cached {
+		t.Error("Singleton lỗi không nên được cache")
+	}
+
+	first, err := cont.Make("db")
+	if err != nil {
+		t.Fatalf("Make() lỗi ở lần khởi tạo thứ hai: %v", err)
+	}
+	second, _ := cont.Make("db")
+
+	if first != second || calls != 2 {
+		t.Errorf("Singleton không được cache sau khi khởi tạo thành công, calls: %d", calls)
+	}
+}
+
+// tenantKey là key của context value dùng trong test
+type tenantKey struct{}
+
+// TestMakeContext kiểm tra ctx được truyền tới factory và các resolve lồng nhau
+func TestMakeContext(t *testing.T) {
+	container := New()
+
+	container.BindContext("tenant", func(ctx context.Context, c Container) (interface{}, error) {
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		return tenant, nil
+	})
+	container.Bind("report", func(c Container) interface{} {
+		return "report for " + c.MustMake("tenant").(string)
+	})
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+
+	tenant, err := container.MakeContext(ctx, "tenant")
+	if err != nil || tenant != "acme" {
+		t.Errorf("MakeContext() không truyền ctx tới factory: %v, %v", tenant, err)
+	}
+
+	// ctx đi qua factory không nhận ctx tới resolve lồng nhau
+	report, err := container.MakeContext(ctx, "report")
+	if err != nil || report != "report for acme" {
+		t.Errorf("MakeContext() không truyền ctx qua resolve lồng nhau: %v, %v", report, err)
+	}
+
+	// Make dùng context.Background()
+	if tenant, _ := container.Make("tenant"); tenant != "" {
+		t.Errorf("Make() nên truyền context.Background(), got: %v", tenant)
+	}
+
+	// CallContext truyền ctx khi resolve tham số
+	container.BindContext("*di.MockService", func(ctx context.Context, c Container) (interface{}, error) {
+		return NewMockService(ctx.Value(tenantKey{}).(string)), nil
+	})
+	results, err := container.CallContext(ctx, func(s *MockService) string { return s.ID })
+	if err != nil || results[0] != "acme" {
+		t.Errorf("CallContext() không truyền ctx khi resolve tham số: %v, %v", results, err)
+	}
+}
+
+// TestMakeContextCancellation kiểm tra huỷ ctx dừng lượt resolve đang bị chặn
+func TestMakeContextCancellation(t *testing.T) {
+	container := New()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	container.BindContext("socket", func(ctx context.Context, c Container) (interface{}, error) {
+		<-release
+		return "connected", nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := container.MakeContext(ctx, "socket")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("MakeContext() nên trả về ctx.Err() khi factory bị chặn, got: %v", err)
+	}
+
+	// Factory nhận context bị chặn phía sau một binding thông thường cũng trả về ngay khi ctx hết hạn
+	container.Bind("client", func(c Container) interface{} { return c.MustMake("socket") })
+
+	nestedCtx, cancelNested := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelNested()
+
+	start := time.Now()
+	_, err = container.MakeContext(nestedCtx, "client")
+	var resolutionErr *ResolutionError
+	if !errors.Is(err, context.DeadlineExceeded) || !errors.As(err, &resolutionErr) || resolutionErr.Key != "socket" {
+		t.Errorf("MakeContext() nên trả về ctx.Err() của dependency lồng nhau bị chặn, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("MakeContext() nên trả về ngay khi ctx hết hạn, mất: %v", elapsed)
+	}
+
+	// ctx đã huỷ: factory không được gọi
+	called := false
+	container.Bind("lazy", func(c Container) interface{} {
+		called = true
+		return "lazy"
+	})
+
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+
+	if _, err := container.MakeContext(cancelled, "lazy"); !errors.Is(err, context.Canceled) || called {
+		t.Errorf("MakeContext() với ctx đã huỷ không nên gọi factory: %v, called: %v", err, called)
+	}
+
+	if _, err := container.CallContext(cancelled, func(s *MockService) {}); !errors.Is(err, context.Canceled) {
+		t.Errorf("CallContext() với ctx đã huỷ nên trả về ctx.Err(), got: %v", err)
+	}
+
+	// Binding không nhận context được khởi tạo trực tiếp; ctx bị huỷ trong lúc khởi tạo vẫn trả về ctx.Err()
+	during, cancelDuring := context.WithCancel(context.Background())
+	container.Bind("cancels", func(c Container) interface{} {
+		cancelDuring()
+		return "built"
+	})
+	if _, err := container.MakeContext(during, "cancels"); !errors.Is(err, context.Canceled) {
+		t.Errorf("MakeContext() nên trả về ctx.Err() khi ctx bị huỷ trong lúc khởi tạo, got: %v", err)
+	}
+
+	// Panic của factory vẫn được truyền về goroutine gọi MakeContext
+	container.Bind("panics", func(c Container) interface{} { panic("boom") })
+	live, stop := context.WithCancel(context.Background())
+	defer stop()
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("MakeContext() nên truyền lại panic của factory, got: %v", r)
+			}
+		}()
+		_, _ = container.MakeContext(live, "panics")
+	}()
+}
+
+// TestSingletonContext kiểm tra singleton lỗi do ctx không được cache
+func TestSingletonContext(t *testing.T) {
+	cont := New()
+
+	calls := 0
+	cont.SingletonContext("pool", func(ctx context.Context, c Container) (interface{}, error) {
+		calls++
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return NewMockService("pool"), nil
+	})
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Gọi factory trực tiếp qua resolver mang ctx đã huỷ
+	impl := cont.(*container)
+	_, err := impl.build(resolveState{ctx: cancelled, chain: []string{"pool"}}, impl.bindings["pool"])
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Factory nên nhận ctx đã huỷ, got: %v", err)
+	}
+
+	first, err := cont.MakeContext(context.Background(), "pool")
+	if err != nil {
+		t.Fatalf("MakeContext() lỗi sau khi ctx trước bị huỷ: %v", err)
+	}
+	second, _ := cont.Make("pool")
+
+	if first != second || calls != 2 {
+		t.Errorf("Singleton lỗi do ctx không nên được cache, calls: %d", calls)
+	}
+}
+
+// TestMakeWith kiểm tra factory nhận tham số runtime của MakeWith
+func TestMakeWith(t *testing.T) {
+	cont := New()
+
+	cont.BindWith("report.generator", func(c Container, params Params) (interface{}, error) {
+		tenant, ok := params["tenant"].(string)
+		if !ok {
+			return nil, errors.New("tenant is required")
+		}
+		return NewMockService("report-" + tenant), nil
+	})
+	cont.Alias("report.generator", "reports")
+
+	report, err := cont.MakeWith("reports", Params{"tenant": "acme"})
+	if err != nil || report.(*MockService).ID != "report-acme" {
+		t.Errorf("MakeWith() nên truyền params tới factory: %v, %v", report, err)
+	}
+
+	if _, err := cont.Make("report.generator"); err == nil || !strings.Contains(err.Error(), "tenant is required") {
+		t.Errorf("Make() nên gọi factory với params nil, got: %v", err)
+	}
+}
+
+// TestMakeWithOverridesNested kiểm tra params thay thế dependency lồng nhau cùng key
+func TestMakeWithOverridesNested(t *testing.T) {
+	cont := New()
+
+	cont.Instance("http.base_url", "https://default.example")
+	cont.Alias("http.base_url", "base_url")
+	cont.Bind("http.transport", func(c Container) interface{} {
+		return &MockDependencyA{Value: c.MustMake("base_url").(string)}
+	})
+	cont.Bind("http.client", func(c Container) interface{} {
+		return &MockDependencyB{Value: c.MustMake("http.transport").(*MockDependencyA).Value}
+	})
+	cont.Singleton("shared.transport", func(c Container) interface{} {
+		return &MockDependencyA{Value: c.MustMake("http.base_url").(string)}
+	})
+	cont.Bind("http.shared_client", func(c Container) interface{} {
+		return &MockDependencyB{Value: c.MustMake("shared.transport").(*MockDependencyA).Value}
+	})
+
+	client := cont.MustMake("http.client").(*MockDependencyB)
+	if client.Value != "https://default.example" {
+		t.Errorf("Make() nên dùng dependency đã đăng ký, got: %s", client.Value)
+	}
+
+	overridden, err := cont.MakeWith("http.client", Params{"http.base_url": "https://tenant.example"})
+	if err != nil || overridden.(*MockDependencyB).Value != "https://tenant.example" {
+		t.Errorf("Params nên thay thế dependency lồng nhau qua alias: %v, %v", overridden, err)
+	}
+
+	// Singleton lồng nhau được khởi tạo không có params
+	shared, err := cont.MakeWith("http.shared_client", Params{"http.base_url": "https://tenant.example"})
+	if err != nil || shared.(*MockDependencyB).Value != "https://default.example" {
+		t.Errorf("Singleton lồng nhau không nên nhận params: %v, %v", shared, err)
+	}
+}
+
+// TestMakeWithSharedBindings kiểm tra binding dùng chung từ chối tham số
+func TestMakeWithSharedBindings(t *testing.T) {
+	cont := New()
+
+	cont.Singleton("db", func(c Container) interface{} { return NewMockService("db") })
+	cont.Scoped("request", func(c Container) interface{} { return NewMockService("request") })
+	cont.Instance("config", "value")
+
+	for _, key := range []string{"db", "request", "config"} {
+		_, err := cont.MakeWith(key, Params{"dsn": "mysql://"})
+		var resolutionErr *ResolutionError
+		if !errors.Is(err, ErrParamsNotAllowed) || !errors.As(err, &resolutionErr) || resolutionErr.Key != key {
+			t.Errorf("MakeWith(%q) nên trả về ErrParamsNotAllowed, got: %v", key, err)
+		}
+	}
+
+	// Params rỗng tương đương Make
+	if db, err := cont.MakeWith("db", nil); err != nil || db != cont.MustMake("db") {
+		t.Errorf("MakeWith() với params rỗng nên tương đương Make: %v, %v", db, err)
+	}
+
+	// MakeWith trong factory cũng từ chối tham số cho singleton
+	cont.BindE("consumer", func(c Container) (interface{}, error) {
+		return c.MakeWith("db", Params{"dsn": "mysql://"})
+	})
+	if _, err := cont.Make("consumer"); !errors.Is(err, ErrParamsNotAllowed) {
+		t.Errorf("MakeWith() trong factory nên trả về ErrParamsNotAllowed, got: %v", err)
 	}
 }
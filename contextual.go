@@ -0,0 +1,133 @@
+package di
+
+// ContextualBindingBuilder xây dựng contextual binding: implementation của một abstract
+// phụ thuộc vào key đang được khởi tạo (consumer).
+//
+// Ví dụ: "session.store" nhận "cache" lưu trong bộ nhớ, còn "report.generator" nhận "cache" lưu trên đĩa:
+//
+//	c.When("session.store").Needs("cache").GiveKey("cache.memory")
+//	c.When("report.generator").Needs("cache").GiveKey("cache.disk")
+type ContextualBindingBuilder interface {
+	// Needs chọn abstract mà consumer cần.
+	Needs(abstract string) ContextualBindingBuilder
+
+	// Give đăng ký factory cung cấp abstract cho consumer; factory được gọi mỗi lần consumer resolve abstract.
+	Give(concrete BindingFunc)
+
+	// GiveInstance cung cấp một instance có sẵn cho consumer.
+	GiveInstance(instance interface{})
+
+	// GiveKey cung cấp instance của một key khác cho consumer.
+	GiveKey(abstract string)
+}
+
+// contextualBinding là một rule contextual binding đã đăng ký.
+type contextualBinding struct {
+	// consumer là key đang được khởi tạo khi rule được áp dụng.
+	consumer string
+
+	// needs là abstract mà consumer resolve.
+	needs string
+
+	// concrete là factory thay thế binding thông thường của needs.
+	concrete BindingFunc
+}
+
+// contextualBuilder là hiện thực của ContextualBindingBuilder.
+type contextualBuilder struct {
+	container *container
+	consumers []string
+	needs     string
+}
+
+// When bắt đầu đăng ký contextual binding cho một hoặc nhiều consumer.
+//
+//   - Mục đích: Cho phép hai service cần hai implementation khác nhau của cùng một abstract.
+//   - Logic: Khi factory của consumer (hoặc callback Call bên trong factory đó) resolve abstract
+//     đã chọn qua Needs, container dùng implementation từ Give/GiveInstance/GiveKey thay cho binding thông thường.
+//     Consumer và abstract được so khớp sau khi resolve alias, nên rule áp dụng cho cả alias.
+//     Rule trên container con được ưu tiên hơn rule trên container cha.
+//   - Tham số:
+//   - consumers: ...string — key của các service nhận implementation riêng.
+//   - Trả về: ContextualBindingBuilder — gọi tiếp Needs(...).Give(...).
+func (c *container) When(consumers ...string) ContextualBindingBuilder {
+	return &contextualBuilder{container: c, consumers: consumers}
+}
+
+// Needs chọn abstract mà consumer cần.
+func (b *contextualBuilder) Needs(abstract string) ContextualBindingBuilder {
+	return &contextualBuilder{container: b.container, consumers: b.consumers, needs: abstract}
+}
+
+// Give đăng ký factory cung cấp abstract cho consumer.
+func (b *contextualBuilder) Give(concrete BindingFunc) {
+	b.container.addContextual(b.consumers, b.needs, concrete)
+}
+
+// GiveInstance cung cấp một instance có sẵn cho consumer.
+func (b *contextualBuilder) GiveInstance(instance interface{}) {
+	b.Give(func(Container) interface{} {
+		return instance
+	})
+}
+
+// GiveKey cung cấp instance của abstract cho consumer, resolve trong chuỗi resolve hiện tại.
+func (b *contextualBuilder) GiveKey(abstract string) {
+	b.Give(func(c Container) interface{} {
+		instance, err := c.Make(abstract)
+		if err != nil {
+			return &bindingFailure{err: err}
+		}
+		return instance
+	})
+}
+
+// addContextual lưu rule cho từng consumer; rule trùng consumer và abstract được ghi đè.
+//
+// Slice rule được thay mới thay vì sửa tại chỗ, để contextualConcrete đọc snapshot mà không cần giữ lock.
+func (c *container) addContextual(consumers []string, needs string, concrete BindingFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rules := make([]contextualBinding, 0, len(c.contextual)+len(consumers))
+	for _, existing := range c.contextual {
+		if existing.needs != needs || !containsString(consumers, existing.consumer) {
+			rules = append(rules, existing)
+		}
+	}
+
+	for _, consumer := range consumers {
+		rules = append(rules, contextualBinding{consumer: consumer, needs: needs, concrete: concrete})
+	}
+
+	c.contextual = rules
+}
+
+// contextualConcrete tìm factory contextual cho abstract khi được resolve từ consumer.
+//
+// consumer và abstract là key gốc (đã resolve alias); rule được so khớp sau khi resolve alias,
+// từ container hiện tại lên các container cha. Trong cùng container, rule đăng ký sau được ưu tiên.
+func (c *container) contextualConcrete(consumer, abstract string) (BindingFunc, bool) {
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		rules := current.contextual
+		current.mu.RUnlock()
+
+		for i := len(rules) - 1; i >= 0; i-- {
+			rule := rules[i]
+			if c.canonical(rule.consumer) == consumer && c.canonical(rule.needs) == abstract {
+				return rule.concrete, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// consumerConcrete tìm factory contextual cho abstract khi được resolve trong chuỗi state.chain.
+func (c *container) consumerConcrete(state resolveState, abstract string) (BindingFunc, bool) {
+	if len(state.chain) == 0 {
+		return nil, false
+	}
+	return c.contextualConcrete(state.chain[len(state.chain)-1], abstract)
+}
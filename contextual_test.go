@@ -0,0 +1,108 @@
+package di
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestContextualBinding kiểm tra mỗi consumer nhận implementation riêng của cùng một abstract
+func TestContextualBinding(t *testing.T) {
+	container := New()
+
+	container.Bind("cache", func(c Container) interface{} { return NewMockService("cache.default") })
+	container.Bind("cache.disk", func(c Container) interface{} { return NewMockService("cache.disk") })
+	container.Bind("session.store", func(c Container) interface{} {
+		return &MockDependencyB{Value: c.MustMake("cache").(*MockService).ID}
+	})
+	container.Singleton("report.generator", func(c Container) interface{} {
+		return &MockDependencyB{Value: c.MustMake("cache").(*MockService).ID}
+	})
+	container.Bind("mailer", func(c Container) interface{} {
+		return &MockDependencyB{Value: c.MustMake("cache").(*MockService).ID}
+	})
+
+	container.When("session.store").Needs("cache").Give(func(c Container) interface{} {
+		return NewMockService("cache.memory")
+	})
+	container.When("report.generator").Needs("cache").GiveKey("cache.disk")
+
+	if store := container.MustMake("session.store").(*MockDependencyB); store.Value != "cache.memory" {
+		t.Errorf("session.store nên nhận cache.memory, got: %s", store.Value)
+	}
+	if report := container.MustMake("report.generator").(*MockDependencyB); report.Value != "cache.disk" {
+		t.Errorf("report.generator nên nhận cache.disk, got: %s", report.Value)
+	}
+	if mailer := container.MustMake("mailer").(*MockDependencyB); mailer.Value != "cache.default" {
+		t.Errorf("Consumer không có rule nên nhận binding thông thường, got: %s", mailer.Value)
+	}
+	if cache := container.MustMake("cache").(*MockService); cache.ID != "cache.default" {
+		t.Errorf("Make() cấp cao nhất không nên áp dụng contextual binding, got: %s", cache.ID)
+	}
+}
+
+// TestContextualBindingAliases kiểm tra rule áp dụng qua alias của consumer và abstract
+func TestContextualBindingAliases(t *testing.T) {
+	container := New()
+
+	container.Instance("logger", "default-logger")
+	container.Alias("logger", "log")
+	container.Bind("payment.gateway", func(c Container) interface{} {
+		return c.MustMake("log")
+	})
+	container.Alias("payment.gateway", "payments")
+
+	container.When("payments").Needs("logger").GiveInstance("audit-logger")
+
+	if logger := container.MustMake("payment.gateway"); logger != "audit-logger" {
+		t.Errorf("Rule đăng ký qua alias nên được áp dụng, got: %v", logger)
+	}
+
+	// Rule đăng ký sau ghi đè rule trước cho cùng consumer và abstract
+	container.When("payment.gateway").Needs("log").GiveInstance("secure-logger")
+	if logger := container.MustMake("payments"); logger != "secure-logger" {
+		t.Errorf("Rule đăng ký sau nên được ưu tiên, got: %v", logger)
+	}
+}
+
+// TestContextualBindingNested kiểm tra rule áp dụng trong Call và container con
+func TestContextualBindingNested(t *testing.T) {
+	parent := New()
+
+	parent.Instance("*di.MockDependencyA", &MockDependencyA{Value: "default"})
+	parent.Bind("handler", func(c Container) interface{} {
+		results, err := c.Call(func(a *MockDependencyA) string { return a.Value })
+		if err != nil {
+			return &bindingFailure{err: err}
+		}
+		return results[0]
+	})
+	parent.When("handler").Needs("*di.MockDependencyA").GiveInstance(&MockDependencyA{Value: "parent"})
+
+	if value := parent.MustMake("handler"); value != "parent" {
+		t.Errorf("Call() trong factory nên áp dụng contextual binding, got: %v", value)
+	}
+
+	child := parent.NewChild()
+	child.When("handler").Needs("*di.MockDependencyA").GiveInstance(&MockDependencyA{Value: "child"})
+
+	if value := child.MustMake("handler"); value != "child" {
+		t.Errorf("Rule của container con nên được ưu tiên, got: %v", value)
+	}
+	if value := parent.MustMake("handler"); value != "parent" {
+		t.Errorf("Rule của container con không nên ảnh hưởng container cha, got: %v", value)
+	}
+
+	parent.When("handler").Needs("*di.MockDependencyA").GiveKey("missing")
+	if _, err := parent.Make("handler"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GiveKey() tới key không tồn tại nên trả về ErrNotFound, got: %v", err)
+	}
+
+	parent.Reset()
+	parent.Instance("*di.MockDependencyA", &MockDependencyA{Value: "default"})
+	parent.Bind("handler", func(c Container) interface{} {
+		return c.MustMake("*di.MockDependencyA").(*MockDependencyA).Value
+	})
+	if value := parent.MustMake("handler"); value != "default" {
+		t.Errorf("Reset() nên xoá contextual binding, got: %v", value)
+	}
+}
@@ -0,0 +1,190 @@
+package di
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// BindingKind là loại đăng ký của một key.
+type BindingKind string
+
+const (
+	// KindBinding là binding transient (Bind, BindE, Provide, BindConstructor, ...).
+	KindBinding BindingKind = "binding"
+
+	// KindSingleton là binding singleton.
+	KindSingleton BindingKind = "singleton"
+
+	// KindScoped là binding scoped.
+	KindScoped BindingKind = "scoped"
+
+	// KindInstance là instance đăng ký qua Instance.
+	KindInstance BindingKind = "instance"
+
+	// KindAlias là alias trỏ tới một key khác.
+	KindAlias BindingKind = "alias"
+)
+
+// BindingInfo mô tả một key đã đăng ký, do Describe trả về.
+//
+//   - Trường:
+//   - Key: string — key đã đăng ký.
+//   - Kind: BindingKind — loại đăng ký.
+//   - Alias: string — key mà alias trỏ tới trực tiếp (chỉ với KindAlias).
+//   - Built: bool — singleton đã được khởi tạo và cache; luôn true với KindInstance.
+//   - Type: reflect.Type — kiểu cụ thể của instance đã cache, nil nếu chưa có.
+//   - Source: string — vị trí "file:line" của lời gọi đăng ký, rỗng nếu không xác định.
+//   - Inherited: bool — key được đăng ký trên container cha.
+type BindingInfo struct {
+	Key       string
+	Kind      BindingKind
+	Alias     string
+	Built     bool
+	Type      reflect.Type
+	Source    string
+	Inherited bool
+}
+
+// String trả về mô tả một dòng của b, dùng cho log khởi động.
+func (b BindingInfo) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s [%s]", b.Key, b.Kind)
+	if b.Alias != "" {
+		fmt.Fprintf(&sb, " -> %s", b.Alias)
+	}
+	if b.Type != nil {
+		fmt.Fprintf(&sb, " %s", b.Type)
+	}
+	if b.Kind == KindSingleton && !b.Built {
+		sb.WriteString(" (not built)")
+	}
+	if b.Inherited {
+		sb.WriteString(" (inherited)")
+	}
+	if b.Source != "" {
+		fmt.Fprintf(&sb, " at %s", b.Source)
+	}
+	return sb.String()
+}
+
+// Describe liệt kê mọi key đã đăng ký trên container, sắp xếp theo key.
+//
+//   - Mục đích: Cho công cụ quản trị và log khởi động biết container đang giữ những gì.
+//   - Logic:
+//   - Mỗi key có một BindingInfo: binding, instance và alias của container hiện tại,
+//     cùng các key của container cha chưa bị container hiện tại ghi đè (Inherited).
+//   - Describe chỉ đọc: không resolve, không khởi tạo singleton và không gọi hook.
+//   - Binding scoped không có instance ở cấp container nên Built luôn false.
+//   - Trả về: []BindingInfo — snapshot tại thời điểm gọi, an toàn khi dùng đồng thời với đăng ký và resolve.
+func (c *container) Describe() []BindingInfo {
+	var infos []BindingInfo
+	seen := make(map[string]bool)
+
+	for current := c; current != nil; current = current.parent {
+		for _, info := range current.describeOwn() {
+			if seen[info.Key] {
+				continue
+			}
+			seen[info.Key] = true
+			info.Inherited = current != c
+			infos = append(infos, info)
+		}
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Key < infos[j].Key
+	})
+
+	return infos
+}
+
+// describeOwn trả về BindingInfo của các key đăng ký trực tiếp trên c.
+func (c *container) describeOwn() []BindingInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	infos := make([]BindingInfo, 0, len(c.bindings)+len(c.instances)+len(c.aliases))
+
+	for key := range c.bindings {
+		info := BindingInfo{Key: key, Kind: KindBinding, Source: c.sources[key].String()}
+		switch c.lifetimes[key] {
+		case lifetimeSingleton:
+			info.Kind = KindSingleton
+		case lifetimeScoped:
+			info.Kind = KindScoped
+		}
+
+		if instance, built := c.instances[key]; built {
+			// Instance đăng ký sau binding transient/scoped thay thế binding khi resolve
+			if info.Kind != KindSingleton {
+				info.Kind = KindInstance
+			}
+			info.Built = true
+			info.Type = reflect.TypeOf(instance)
+		}
+		infos = append(infos, info)
+	}
+
+	for key, instance := range c.instances {
+		if _, isBinding := c.bindings[key]; isBinding {
+			continue
+		}
+		infos = append(infos, BindingInfo{
+			Key:    key,
+			Kind:   KindInstance,
+			Built:  true,
+			Type:   reflect.TypeOf(instance),
+			Source: c.sources[key].String(),
+		})
+	}
+
+	for alias, abstract := range c.aliases {
+		infos = append(infos, BindingInfo{Key: alias, Kind: KindAlias, Alias: abstract, Source: c.aliasSources[alias].String()})
+	}
+
+	return infos
+}
+
+// packagePrefix là tiền tố tên hàm của package này trong stack trace, ví dụ "go.fork.vn/di.".
+var packagePrefix = reflect.TypeOf(container{}).PkgPath() + "."
+
+// callSiteDepth là số frame được ghi nhận cho mỗi lời gọi đăng ký, đủ để vượt qua các hàm public
+// bọc register (BindIf → Bind, BindConstructor → BindE, ...).
+const callSiteDepth = 8
+
+// callSite là các program counter của một lời gọi đăng ký, chỉ được chuyển thành "file:line" khi Describe,
+// để việc đăng ký (kể cả Instance theo từng request) không phải trả chi phí phân giải stack.
+type callSite [callSiteDepth]uintptr
+
+// callerSite ghi nhận stack của lời gọi đăng ký, bỏ qua hàm gọi callerSite (register, Instance, Alias).
+func callerSite() callSite {
+	var site callSite
+	runtime.Callers(3, site[:])
+	return site
+}
+
+// String trả về vị trí "file:line" của lời gọi đăng ký: frame đầu tiên nằm ngoài package này
+// (frame trong file _test.go của package được coi là caller); "" nếu không xác định được.
+func (s callSite) String() string {
+	n := 0
+	for n < len(s) && s[n] != 0 {
+		n++
+	}
+	if n == 0 {
+		return ""
+	}
+
+	frames := runtime.CallersFrames(s[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePrefix) || strings.HasSuffix(frame.File, "_test.go") {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package di
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestDescribe kiểm tra thông tin của từng loại đăng ký
+func TestDescribe(t *testing.T) {
+	container := New()
+
+	container.Bind("mailer", func(c Container) interface{} { return NewMockService("mailer") })
+	container.Singleton("db", func(c Container) interface{} { return NewMockService("db") })
+	container.Singleton("cache", func(c Container) interface{} { return NewMockService("cache") })
+	container.Scoped("request", func(c Container) interface{} { return NewMockService("request") })
+	container.Instance("config", map[string]string{})
+	container.Alias("db", "database")
+	ProvideInstance(container, NewMockService("typed"))
+
+	container.MustMake("database")
+
+	infos := container.Describe()
+	byKey := make(map[string]BindingInfo)
+	for i, info := range infos {
+		byKey[info.Key] = info
+		if i > 0 && infos[i-1].Key >= info.Key {
+			t.Errorf("Describe nên sắp xếp theo key, got: %s trước %s", infos[i-1].Key, info.Key)
+		}
+	}
+	if len(infos) != 7 {
+		t.Fatalf("Describe nên trả về 7 key, got: %v", infos)
+	}
+
+	mockServiceType := reflect.TypeOf(&MockService{})
+	cases := map[string]BindingInfo{
+		"mailer":              {Kind: KindBinding},
+		"db":                  {Kind: KindSingleton, Built: true, Type: mockServiceType},
+		"cache":               {Kind: KindSingleton},
+		"request":             {Kind: KindScoped},
+		"config":              {Kind: KindInstance, Built: true, Type: reflect.TypeOf(map[string]string{})},
+		"database":            {Kind: KindAlias, Alias: "db"},
+		KeyOf[*MockService](): {Kind: KindInstance, Built: true, Type: mockServiceType},
+	}
+	for key, expected := range cases {
+		info := byKey[key]
+		if info.Kind != expected.Kind || info.Alias != expected.Alias || info.Built != expected.Built || info.Type != expected.Type {
+			t.Errorf("Describe()[%s] = %+v, expected %+v", key, info, expected)
+		}
+		if !strings.Contains(info.Source, "describe_test.go:") {
+			t.Errorf("Source của %s nên trỏ tới lời gọi đăng ký, got: %q", key, info.Source)
+		}
+	}
+
+	if s := byKey["cache"].String(); !strings.HasPrefix(s, "cache [singleton] (not built) at ") {
+		t.Errorf("String() không đúng định dạng, got: %s", s)
+	}
+	if s := byKey["database"].String(); !strings.HasPrefix(s, "database [alias] -> db at ") {
+		t.Errorf("String() của alias không đúng định dạng, got: %s", s)
+	}
+
+	// Forget và RemoveAlias xoá key khỏi Describe
+	container.Forget("mailer")
+	container.RemoveAlias("database")
+	for _, info := range container.Describe() {
+		if info.Key == "mailer" || info.Key == "database" {
+			t.Errorf("Key đã xoá không nên xuất hiện trong Describe, got: %+v", info)
+		}
+	}
+}
+
+// TestDescribeChild kiểm tra Describe của container con gồm key kế thừa từ container cha
+func TestDescribeChild(t *testing.T) {
+	parent := New()
+	parent.Bind("shared", func(c Container) interface{} { return "parent" })
+	parent.Bind("overridden", func(c Container) interface{} { return "parent" })
+
+	child := parent.NewChild()
+	child.Instance("overridden", "child")
+
+	infos := child.Describe()
+	if len(infos) != 2 {
+		t.Fatalf("Describe của container con nên có 2 key, got: %v", infos)
+	}
+	if infos[0].Key != "overridden" || infos[0].Kind != KindInstance || infos[0].Inherited {
+		t.Errorf("Key ghi đè nên lấy từ container con, got: %+v", infos[0])
+	}
+	if infos[1].Key != "shared" || !infos[1].Inherited {
+		t.Errorf("Key của container cha nên được đánh dấu Inherited, got: %+v", infos[1])
+	}
+
+	// BindConstructor ghi nhận nơi gọi của người dùng
+	child.BindConstructor(newUserRepo)
+	for _, info := range child.Describe() {
+		if info.Key == KeyOf[*userRepo]() && !strings.Contains(info.Source, "describe_test.go:") {
+			t.Errorf("Source của BindConstructor nên trỏ tới lời gọi đăng ký, got: %q", info.Source)
+		}
+	}
+}
+
+// TestDescribeConcurrent kiểm tra Describe an toàn khi chạy đồng thời với đăng ký và resolve
+func TestDescribeConcurrent(t *testing.T) {
+	container := New()
+	container.Singleton("db", func(c Container) interface{} { return NewMockService("db") })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			container.Describe()
+		}()
+		go func() {
+			defer wg.Done()
+			container.MustMake("db")
+		}()
+		go func() {
+			defer wg.Done()
+			container.Bind("mailer", func(c Container) interface{} { return "mailer" })
+		}()
+	}
+	wg.Wait()
+}
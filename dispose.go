@@ -0,0 +1,181 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// contextCloser là instance giải phóng tài nguyên qua Close(ctx) error.
+type contextCloser interface {
+	Close(ctx context.Context) error
+}
+
+// shutdowner là instance giải phóng tài nguyên qua Shutdown(ctx) error (ví dụ: *http.Server).
+type shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+// disposable là một instance cần được giải phóng khi container (hoặc scope) đóng.
+type disposable struct {
+	// abstract là key mà instance được tạo hoặc đăng ký.
+	abstract string
+
+	// instance là instance cần giải phóng.
+	instance interface{}
+
+	// supplied đánh dấu instance do caller giao qua Instance (không phải do container tạo).
+	supplied bool
+}
+
+// isDisposable kiểm tra instance có phương thức giải phóng mà Close nhận biết.
+func isDisposable(instance interface{}) bool {
+	switch instance.(type) {
+	case contextCloser, shutdowner, io.Closer:
+		return true
+	}
+	return false
+}
+
+// trackDisposable ghi nhận instance cần giải phóng vào disposables theo thứ tự tạo;
+// supplied đánh dấu instance do caller giao qua Instance.
+//
+// Instance không có phương thức giải phóng, hoặc đã được ghi nhận (cùng instance dưới key khác), bị bỏ qua.
+func trackDisposable(disposables []disposable, abstract string, instance interface{}, supplied bool) []disposable {
+	if !isDisposable(instance) {
+		return disposables
+	}
+
+	if reflect.TypeOf(instance).Comparable() {
+		for _, tracked := range disposables {
+			if tracked.instance == instance {
+				return disposables
+			}
+		}
+	}
+
+	return append(disposables, disposable{abstract: abstract, instance: instance, supplied: supplied})
+}
+
+// untrackDisposable bỏ ghi nhận instance do caller giao (Instance) của abstract khỏi disposables trước khi
+// instance bị xoá hoặc thay thế; c.mu phải được giữ.
+//
+// Instance bị bỏ ghi nhận thuộc về caller và không được Close giải phóng. Nếu instance vẫn được giữ dưới key khác,
+// nó tiếp tục được ghi nhận dưới key đó. Singleton do container tạo vẫn được ghi nhận và được giải phóng khi Close.
+func (c *container) untrackDisposable(abstract string) {
+	instance, exists := c.instances[abstract]
+	if !exists {
+		return
+	}
+	canCompare := instance != nil && reflect.TypeOf(instance).Comparable()
+
+	for i, tracked := range c.disposables {
+		if !tracked.supplied || (tracked.abstract != abstract && !(canCompare && tracked.instance == instance)) {
+			continue
+		}
+
+		if other, held := c.instanceKey(tracked.instance, abstract); held {
+			c.disposables[i].abstract = other
+			return
+		}
+		c.disposables = append(c.disposables[:i:i], c.disposables[i+1:]...)
+		return
+	}
+}
+
+// instanceKey tìm key khác except đang giữ instance; c.mu phải được giữ.
+func (c *container) instanceKey(instance interface{}, except string) (string, bool) {
+	if instance == nil || !reflect.TypeOf(instance).Comparable() {
+		return "", false
+	}
+	for key, held := range c.instances {
+		if key != except && held == instance {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// disposeAll giải phóng các instance theo thứ tự ngược với thứ tự tạo và tổng hợp lỗi.
+//
+// Mọi instance đều được giải phóng kể cả khi ctx đã bị huỷ hoặc một instance panic khi giải phóng; ctx được truyền cho Close(ctx)/Shutdown(ctx)
+// để chúng tự quyết định dừng sớm. self (container hoặc scope đang đóng) được bỏ qua nếu nó được đăng ký
+// làm instance của chính nó.
+func disposeAll(ctx context.Context, disposables []disposable, self interface{}) error {
+	var errs []error
+	for i := len(disposables) - 1; i >= 0; i-- {
+		tracked := disposables[i]
+		if tracked.instance == self {
+			continue
+		}
+
+		if err := dispose(ctx, tracked.instance); err != nil {
+			errs = append(errs, &DisposeError{Key: tracked.abstract, Cause: err})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// dispose giải phóng một instance; panic của Close/Shutdown được chuyển thành lỗi để các instance còn lại
+// vẫn được giải phóng.
+func dispose(ctx context.Context, instance interface{}) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v", ErrDisposePanic, recovered)
+		}
+	}()
+
+	switch instance := instance.(type) {
+	case contextCloser:
+		return instance.Close(ctx)
+	case shutdowner:
+		return instance.Shutdown(ctx)
+	case io.Closer:
+		return instance.Close()
+	}
+	return nil
+}
+
+// Close giải phóng mọi instance mà container đã tạo hoặc được giao, theo thứ tự ngược với thứ tự tạo.
+//
+//   - Mục đích: Đóng DB pool, file handle, listener, ... khi ứng dụng dừng thay vì để rò rỉ.
+//   - Logic:
+//   - Container ghi nhận instance đăng ký qua Instance và singleton đã khởi tạo thành công,
+//     nếu chúng có Close(ctx) error, Shutdown(ctx) error hoặc Close() error (io.Closer), theo thứ tự ưu tiên đó.
+//   - Singleton được ghi nhận khi khởi tạo xong, nên dependency được giải phóng sau các instance phụ thuộc nó.
+//   - Instance transient thuộc về caller và không được ghi nhận; scoped instance được giải phóng bởi scope.
+//   - Singleton của container cha resolve qua container con thuộc về container cha; Close của container
+//     con chỉ giải phóng instance của chính nó.
+//   - Instance đăng ký qua Instance bị xoá (Forget/ForgetInstance) hoặc bị thay thế (Instance) không còn được
+//     ghi nhận; caller chịu trách nhiệm giải phóng chúng. Singleton do container tạo vẫn được giải phóng khi Close
+//     kể cả khi đã bị bỏ khỏi cache (Forget, ForgetInstance, đăng ký lại, InvalidateDependents).
+//   - Mỗi instance được giải phóng một lần; Close lần sau chỉ giải phóng instance tạo sau lần Close trước.
+//     Đăng ký và cache không bị xoá, nên container không nên được dùng tiếp sau Close.
+//   - Tham số:
+//   - ctx: context.Context — được truyền cho Close(ctx)/Shutdown(ctx).
+//   - Trả về: error — các *DisposeError được tổng hợp bằng errors.Join, nil nếu mọi instance giải phóng thành công.
+func (c *container) Close(ctx context.Context) error {
+	c.mu.Lock()
+	disposables := c.disposables
+	c.disposables = nil
+	c.mu.Unlock()
+
+	return disposeAll(ctx, disposables, c)
+}
+
+// Close kết thúc scope và giải phóng các scoped instance của scope theo thứ tự ngược với thứ tự tạo.
+//
+// Instance được giải phóng như Container.Close; container gốc không bị ảnh hưởng.
+func (s *scope) Close(ctx context.Context) error {
+	s.mu.Lock()
+	s.ended = true
+	s.instances = make(map[string]*pendingInstance)
+	disposables := s.disposables
+	s.disposables = nil
+	s.mu.Unlock()
+
+	return disposeAll(ctx, disposables, s)
+}
@@ -0,0 +1,253 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// closeRecorder ghi lại thứ tự giải phóng của các resource trong test
+type closeRecorder struct {
+	closed []string
+}
+
+// closerResource hiện thực io.Closer
+type closerResource struct {
+	name     string
+	recorder *closeRecorder
+	err      error
+}
+
+func (r *closerResource) Close() error {
+	r.recorder.closed = append(r.recorder.closed, r.name)
+	return r.err
+}
+
+// contextResource hiện thực Close(ctx) error
+type contextResource struct {
+	closerResource
+	ctx context.Context
+}
+
+func (r *contextResource) Close(ctx context.Context) error {
+	r.ctx = ctx
+	return r.closerResource.Close()
+}
+
+// shutdownResource hiện thực Shutdown(ctx) error
+type shutdownResource struct {
+	closerResource
+}
+
+func (r *shutdownResource) Shutdown(ctx context.Context) error {
+	return r.closerResource.Close()
+}
+
+// TestClose kiểm tra Close giải phóng instance theo thứ tự ngược với thứ tự tạo
+func TestClose(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+
+	container.Instance("config", &closerResource{name: "config", recorder: recorder})
+	container.Singleton("db", func(c Container) interface{} {
+		c.MustMake("config")
+		return &contextResource{closerResource: closerResource{name: "db", recorder: recorder}}
+	})
+	container.Singleton("server", func(c Container) interface{} {
+		c.MustMake("db")
+		return &shutdownResource{closerResource{name: "server", recorder: recorder}}
+	})
+	container.Bind("transient", func(c Container) interface{} {
+		return &closerResource{name: "transient", recorder: recorder}
+	})
+	container.Instance("plain", "not disposable")
+
+	container.MustMake("server")
+	container.MustMake("transient")
+	// Cùng instance dưới key khác chỉ được giải phóng một lần
+	container.Instance("database", container.MustMake("db"))
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "shutdown")
+	if err := container.Close(ctx); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	expected := []string{"server", "db", "config"}
+	if len(recorder.closed) != len(expected) {
+		t.Fatalf("Close nên giải phóng %v, got: %v", expected, recorder.closed)
+	}
+	for i := range expected {
+		if recorder.closed[i] != expected[i] {
+			t.Errorf("closed[%d] = %s, expected %s", i, recorder.closed[i], expected[i])
+		}
+	}
+	if db := container.MustMake("db").(*contextResource); db.ctx != ctx {
+		t.Error("Close(ctx) của instance nên nhận ctx của Close")
+	}
+
+	// Close lần hai không giải phóng lại
+	if err := container.Close(ctx); err != nil || len(recorder.closed) != len(expected) {
+		t.Errorf("Close lần hai không nên giải phóng lại, got: %v, %v", recorder.closed, err)
+	}
+}
+
+// TestCloseErrors kiểm tra lỗi giải phóng được tổng hợp
+func TestCloseErrors(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+	errFirst, errSecond := errors.New("first"), errors.New("second")
+
+	container.Instance("first", &closerResource{name: "first", recorder: recorder, err: errFirst})
+	container.Instance("ok", &closerResource{name: "ok", recorder: recorder})
+	container.Instance("second", &closerResource{name: "second", recorder: recorder, err: errSecond})
+	// Container đăng ký làm instance của chính nó không bị giải phóng đệ quy
+	container.Instance("container", container)
+
+	err := container.Close(context.Background())
+	if !errors.Is(err, errFirst) || !errors.Is(err, errSecond) {
+		t.Errorf("Close nên tổng hợp lỗi của mọi instance, got: %v", err)
+	}
+	var disposeErr *DisposeError
+	if !errors.As(err, &disposeErr) || disposeErr.Key != "second" {
+		t.Errorf("Lỗi đầu tiên nên là DisposeError của key giải phóng trước, got: %v", err)
+	}
+	if len(recorder.closed) != 3 {
+		t.Errorf("Lỗi không nên dừng việc giải phóng các instance còn lại, got: %v", recorder.closed)
+	}
+}
+
+// panicResource panic khi Close
+type panicResource struct{}
+
+func (r *panicResource) Close() error {
+	var m map[string]int
+	m["closed"]++
+	return nil
+}
+
+// TestClosePanic kiểm tra Close tiếp tục giải phóng các instance còn lại khi một instance panic
+func TestClosePanic(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+
+	container.Instance("first", &closerResource{name: "first", recorder: recorder})
+	container.Instance("broken", &panicResource{})
+	container.Instance("last", &closerResource{name: "last", recorder: recorder})
+
+	err := container.Close(context.Background())
+	var disposeErr *DisposeError
+	if !errors.Is(err, ErrDisposePanic) || !errors.As(err, &disposeErr) || disposeErr.Key != "broken" {
+		t.Errorf("Panic khi giải phóng nên được trả về như DisposeError wrap ErrDisposePanic, got: %v", err)
+	}
+	if strings.Join(recorder.closed, ",") != "last,first" {
+		t.Errorf("Panic không nên dừng việc giải phóng các instance còn lại, got: %v", recorder.closed)
+	}
+}
+
+// TestCloseScopeAndChild kiểm tra scope và container con giải phóng instance của chính chúng
+func TestCloseScopeAndChild(t *testing.T) {
+	parent := New()
+	recorder := &closeRecorder{}
+
+	parent.Singleton("pool", func(c Container) interface{} {
+		return &closerResource{name: "pool", recorder: recorder}
+	})
+	parent.Scoped("tx", func(c Container) interface{} {
+		c.MustMake("pool")
+		return &closerResource{name: "tx", recorder: recorder}
+	})
+	parent.Scoped("session", func(c Container) interface{} {
+		c.MustMake("tx")
+		return &closerResource{name: "session", recorder: recorder}
+	})
+
+	scope := parent.NewScope()
+	scope.MustMake("session")
+	if err := scope.Close(context.Background()); err != nil {
+		t.Fatalf("Scope Close failed: %v", err)
+	}
+	if len(recorder.closed) != 2 || recorder.closed[0] != "session" || recorder.closed[1] != "tx" {
+		t.Errorf("Scope nên giải phóng scoped instance của nó theo thứ tự ngược, got: %v", recorder.closed)
+	}
+	if _, err := scope.Make("tx"); !errors.Is(err, ErrScopeEnded) {
+		t.Errorf("Scope đã Close nên trả về ErrScopeEnded, got: %v", err)
+	}
+
+	// End giải phóng như Close
+	recorder.closed = nil
+	other := parent.NewScope()
+	other.MustMake("tx")
+	other.End()
+	if len(recorder.closed) != 1 || recorder.closed[0] != "tx" {
+		t.Errorf("End nên giải phóng scoped instance, got: %v", recorder.closed)
+	}
+
+	// Container con chỉ giải phóng instance của chính nó
+	recorder.closed = nil
+	child := parent.NewChild()
+	child.Instance("child.file", &closerResource{name: "child.file", recorder: recorder})
+	child.MustMake("pool")
+	if err := child.Close(context.Background()); err != nil || len(recorder.closed) != 1 || recorder.closed[0] != "child.file" {
+		t.Errorf("Container con chỉ nên giải phóng instance của nó, got: %v, %v", recorder.closed, err)
+	}
+
+	recorder.closed = nil
+	parent.Close(context.Background())
+	if len(recorder.closed) != 1 || recorder.closed[0] != "pool" {
+		t.Errorf("Container cha nên giải phóng singleton của nó, got: %v", recorder.closed)
+	}
+}
+
+// TestCloseForgotten kiểm tra instance do caller giao bị xoá hoặc thay thế không còn được Close giải phóng,
+// còn singleton do container tạo vẫn được giải phóng sau khi bị bỏ khỏi cache
+func TestCloseForgotten(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+	newResource := func(name string) *closerResource {
+		return &closerResource{name: name, recorder: recorder}
+	}
+
+	container.Instance("forgotten", newResource("forgotten"))
+	container.Forget("forgotten")
+
+	container.Singleton("cache", func(c Container) interface{} { return newResource("cache") })
+	container.MustMake("cache")
+	container.ForgetInstance("cache")
+
+	container.Instance("config", newResource("config.old"))
+	container.Instance("config", newResource("config"))
+
+	container.Singleton("db", func(c Container) interface{} { return newResource("db.old") })
+	container.MustMake("db")
+	container.Singleton("db", func(c Container) interface{} { return newResource("db") })
+
+	// Singleton bị bỏ cache qua InvalidateDependents vẫn được giải phóng cùng singleton tạo lại
+	container.Instance("cfg", "v1")
+	pools := 0
+	container.Singleton("pool", func(c Container) interface{} {
+		pools++
+		c.MustMake("cfg")
+		return newResource(fmt.Sprintf("pool-%d", pools))
+	})
+	container.MustMake("pool")
+	container.Forget("cfg", InvalidateDependents())
+	container.Instance("cfg", "v2")
+	container.MustMake("pool")
+
+	// Instance còn được giữ dưới key khác vẫn được giải phóng
+	shared := newResource("shared")
+	container.Instance("shared", shared)
+	container.Instance("shared.alias", shared)
+	container.Forget("shared")
+
+	if err := container.Close(context.Background()); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	expected := []string{"shared", "pool-2", "pool-1", "db.old", "config", "cache"}
+	if strings.Join(recorder.closed, ",") != strings.Join(expected, ",") {
+		t.Errorf("Close nên giải phóng %v, got: %v", expected, recorder.closed)
+	}
+}
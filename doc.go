@@ -10,8 +10,27 @@
 //
 // # Thành phần chính
 //
-//   - container.go: Định nghĩa struct Container, các phương thức quản lý dependency (Bind, Singleton, Instance, Alias, Make, MustMake, Bound, Reset, Call).
-//   - binding.go: Định nghĩa BindingFunc (factory function cho dependency).
+//   - container.go: Định nghĩa struct Container, các phương thức quản lý dependency (Bind, Singleton, BindE, SingletonE, BindContext, SingletonContext, Instance, Alias, Make, MakeContext, MustMake, Bound, Reset, Call, CallContext).
+//   - binding.go: Định nghĩa BindingFunc, BindingFuncE và BindingFuncContext (factory function cho dependency, có thể trả về lỗi hoặc nhận context).
+//   - generic.go: API generic theo kiểu (Provide, ProvideSingleton, ProvideScoped, ProvideInstance, Resolve, MustResolve, MakeAs, KeyOf, TypeKey, ProvideValue).
+//   - errors.go: Các kiểu lỗi của container, dùng được với errors.Is/errors.As.
+//   - resolver.go: View của container truyền vào factory, mang context và chuỗi resolve để phát hiện circular dependency.
+//   - scope.go: Scope và lifetime scoped (một instance cho mỗi request/job).
+//   - child.go: Container con (NewChild) với fallback về container cha và override cục bộ.
+//   - tag.go: Tagged binding (Tag, Tagged, TaggedAs) để resolve một nhóm abstract theo thứ tự đăng ký.
+//   - contextual.go: Contextual binding (When/Needs/Give) — implementation của abstract theo consumer.
+//   - extend.go: Decorator (Extend) bọc service đã đăng ký theo thứ tự đăng ký.
+//   - hooks.go: Hook Resolving/AfterResolving gọi khi factory tạo instance.
+//   - rebinding.go: Callback Rebinding khi key đã resolve bị đăng ký lại.
+//   - forget.go: Forget/ForgetInstance/RemoveAlias và bỏ cache singleton phụ thuộc.
+//   - inject.go: Inject điền field của struct theo tag di hoặc theo kiểu, MakeStruct[T].
+//   - constructor.go: BindConstructor/SingletonConstructor/ScopedConstructor tự wire constructor theo kiểu tham số.
+//   - invoke.go: Invoke, dựng đối số cho Call (variadic, inject Container/context.Context/Application).
+//   - compile.go: Compile/Invoker và cache plan gọi theo kiểu callback.
+//   - dispose.go: Close giải phóng instance và singleton (scope: scoped instance) theo thứ tự ngược với thứ tự tạo.
+//   - describe.go: Describe liệt kê các key đã đăng ký (BindingInfo, BindingKind).
+//   - graph.go: Graph trả về đồ thị phụ thuộc, xuất DOT/Mermaid/JSON.
+//   - validate.go: Validate resolve thử mọi binding trong scope và cache singleton tạm riêng, tổng hợp lỗi (ValidationError).
 //   - provider.go: Định nghĩa interface ServiceProvider, ServiceProviderDeferred, Application (chuẩn hóa contract cho module/service provider/app).
 //   - deferred.go: Hỗ trợ deferred service provider (DeferredBoot sau HTTP request).
 //   - application.go: Chuẩn hóa interface Application cho app sử dụng DI, quản lý provider, binding, resolve, call.
@@ -41,6 +60,8 @@
 //	container.Instance("config", configManager)
 //	container.Alias("logger", "log")
 //	logger := container.MustMake("logger").(log.Logger)
+//	di.Provide(container, func(c di.Container) (*UserService, error) { return NewUserService(), nil })
+//	users := di.MustResolve[*UserService](container)
 //	result, err := container.Call(func(l log.Logger, db database.DB) error { l.Info("Hello"); return db.Ping() })
 //
 // # Service Provider Pattern
@@ -61,6 +82,24 @@
 //
 //   - container.go: Định nghĩa Container, các phương thức quản lý dependency
 //   - binding.go: Định nghĩa BindingFunc
+//   - generic.go: API generic Provide[T]/Resolve[T]
+//   - errors.go: Các kiểu lỗi của container
+//   - scope.go: Scope và lifetime scoped
+//   - child.go: Container con với fallback về container cha
+//   - tag.go: Tagged binding
+//   - contextual.go: Contextual binding
+//   - extend.go: Decorator cho binding, singleton và instance
+//   - hooks.go: Hook vòng đời Resolving/AfterResolving
+//   - rebinding.go: Thông báo khi binding hoặc instance bị thay thế
+//   - forget.go: Gỡ từng binding, instance, alias
+//   - inject.go: Struct injection qua tag di
+//   - constructor.go: Đăng ký constructor Go thông thường
+//   - invoke.go: Gọi hàm với error trả về và tham số variadic
+//   - compile.go: Gọi handler đã biên dịch sẵn
+//   - dispose.go: Giải phóng tài nguyên khi dừng ứng dụng
+//   - describe.go: Liệt kê binding cho công cụ quản trị và log khởi động
+//   - graph.go: Xuất đồ thị phụ thuộc để review kiến trúc
+//   - validate.go: Kiểm tra wiring khi test hoặc khởi động
 //   - provider.go: Định nghĩa ServiceProvider, ServiceProviderDeferred, Application
 //   - deferred.go: Deferred service provider
 //   - application.go: Chuẩn hóa interface Application
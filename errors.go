@@ -0,0 +1,245 @@
+package di
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TypeMismatchError được trả về khi instance resolve từ container không khớp với kiểu mong đợi.
+//
+//   - Mục đích: Thay thế type assertion không kiểm tra (panic runtime) bằng lỗi có kiểu, dùng được với errors.As.
+//   - Trường:
+//   - Key: string — key đã được resolve.
+//   - Expected: reflect.Type — kiểu mà caller yêu cầu.
+//   - Actual: reflect.Type — kiểu thực tế của instance (nil nếu instance là nil).
+type TypeMismatchError struct {
+	Key      string
+	Expected reflect.Type
+	Actual   reflect.Type
+}
+
+// Error hiện thực error interface.
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("type mismatch for %s: expected %v, got %v", e.Key, e.Expected, e.Actual)
+}
+
+// Các lỗi sentinel của container, dùng với errors.Is.
+var (
+	// ErrNotFound là nguyên nhân của ResolutionError khi key chưa được đăng ký binding/instance/alias.
+	ErrNotFound = errors.New("bind not found")
+
+	// ErrCircularDependency là nguyên nhân (Cause) của ResolutionError khi một key phụ thuộc vòng vào chính nó.
+	ErrCircularDependency = errors.New("circular dependency")
+
+	// ErrNotAFunction được Call trả về khi callback không phải function.
+	ErrNotAFunction = errors.New("callback must be a function")
+
+	// ErrScopeRequired là nguyên nhân của ResolutionError khi binding scoped được resolve ngoài scope.
+	ErrScopeRequired = errors.New("scoped binding requires a scope")
+
+	// ErrScopeEnded là nguyên nhân của ResolutionError khi binding scoped được resolve trong scope đã End.
+	ErrScopeEnded = errors.New("scope has ended")
+
+	// ErrParamsNotAllowed là nguyên nhân của ResolutionError khi MakeWith truyền tham số cho binding dùng chung.
+	ErrParamsNotAllowed = errors.New("parameters are not allowed for shared bindings")
+
+	// ErrInvalidInjectTarget được Inject trả về khi target không phải con trỏ (khác nil) tới struct.
+	ErrInvalidInjectTarget = errors.New("inject target must be a non-nil pointer to a struct")
+
+	// ErrUnexportedField là nguyên nhân của FieldError khi field có tag di nhưng không exported.
+	ErrUnexportedField = errors.New("field is not exported")
+
+	// ErrInvalidLazyField là nguyên nhân của FieldError khi field lazy không có dạng func() T hoặc func() (T, error).
+	ErrInvalidLazyField = errors.New("lazy field must be func() T or func() (T, error)")
+
+	// ErrInvalidConstructor được BindConstructor trả về khi constructor không có dạng func(...) T hoặc func(...) (T, error).
+	ErrInvalidConstructor = errors.New("constructor must be func(...) T or func(...) (T, error)")
+
+	// ErrAliasCycle là lỗi của AliasError khi alias tạo thành vòng hoặc trỏ tới chính nó.
+	ErrAliasCycle = errors.New("alias cycle")
+
+	// ErrDisposePanic là nguyên nhân của DisposeError khi Close/Shutdown của instance panic.
+	ErrDisposePanic = errors.New("dispose panicked")
+
+	// ErrNilInstance là giá trị panic của ProvideValue khi instance là nil (không xác định được kiểu).
+	ErrNilInstance = errors.New("instance must not be nil")
+)
+
+// ResolutionError mô tả lỗi xảy ra khi resolve một key, kèm chuỗi resolve dẫn tới lỗi.
+//
+//   - Mục đích: Cho biết lời gọi Make ở cấp cao nhất nào đã kích hoạt lỗi nằm sâu trong các factory lồng nhau.
+//   - Trường:
+//   - Key: string — key gặp lỗi.
+//   - Chain: []string — chuỗi key đang được resolve, từ key cấp cao nhất tới Key.
+//   - Cause: error — lỗi gốc (ví dụ: ErrCircularDependency hoặc lỗi do factory trả về).
+type ResolutionError struct {
+	Key   string
+	Chain []string
+	Cause error
+}
+
+// Error hiện thực error interface.
+func (e *ResolutionError) Error() string {
+	path := strings.Join(e.Chain, " -> ")
+	if errors.Is(e.Cause, ErrCircularDependency) {
+		return fmt.Sprintf("%v: %s", e.Cause, path)
+	}
+	if len(e.Chain) > 1 {
+		return fmt.Sprintf("%v (resolving %s)", e.Cause, path)
+	}
+	return e.Cause.Error()
+}
+
+// Unwrap trả về lỗi gốc để dùng với errors.Is/errors.As.
+func (e *ResolutionError) Unwrap() error {
+	return e.Cause
+}
+
+// ParameterError mô tả lỗi khi Call không resolve được một tham số của callback.
+//
+//   - Trường:
+//   - Index: int — vị trí tham số trong callback.
+//   - Type: reflect.Type — kiểu của tham số.
+//   - Cause: error — lỗi resolve gốc (thường là *ResolutionError).
+type ParameterError struct {
+	Index int
+	Type  reflect.Type
+	Cause error
+}
+
+// Error hiện thực error interface.
+func (e *ParameterError) Error() string {
+	return fmt.Sprintf("cannot resolve parameter %s: %v", e.Type, e.Cause)
+}
+
+// Unwrap trả về lỗi resolve gốc.
+func (e *ParameterError) Unwrap() error {
+	return e.Cause
+}
+
+// FieldError mô tả lỗi khi Inject không inject được một field của struct.
+//
+//   - Trường:
+//   - Field: string — tên field.
+//   - Type: reflect.Type — kiểu của field.
+//   - Cause: error — lỗi resolve gốc (thường là *ResolutionError hoặc *TypeMismatchError).
+type FieldError struct {
+	Field string
+	Type  reflect.Type
+	Cause error
+}
+
+// Error hiện thực error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("cannot inject field %s (%s): %v", e.Field, e.Type, e.Cause)
+}
+
+// Unwrap trả về lỗi resolve gốc.
+func (e *FieldError) Unwrap() error {
+	return e.Cause
+}
+
+// DisposeError mô tả lỗi khi Close không giải phóng được một instance.
+//
+//   - Trường:
+//   - Key: string — key mà instance được tạo hoặc đăng ký.
+//   - Cause: error — lỗi do Close/Shutdown của instance trả về, hoặc lỗi wrap ErrDisposePanic nếu chúng panic.
+type DisposeError struct {
+	Key   string
+	Cause error
+}
+
+// Error hiện thực error interface.
+func (e *DisposeError) Error() string {
+	return fmt.Sprintf("cannot dispose %s: %v", e.Key, e.Cause)
+}
+
+// Unwrap trả về lỗi gốc để dùng với errors.Is/errors.As.
+func (e *DisposeError) Unwrap() error {
+	return e.Cause
+}
+
+// ValidationFailure là một key không resolve được trong Validate.
+type ValidationFailure struct {
+	// Key là key không resolve được.
+	Key string
+
+	// Kind là loại đăng ký của key.
+	Kind BindingKind
+
+	// Source là vị trí "file:line" của lời gọi đăng ký key.
+	Source string
+
+	// Err là lỗi resolve: *ResolutionError (ErrNotFound, ErrCircularDependency, lỗi của factory, ...)
+	// hoặc *PanicError nếu factory panic.
+	Err error
+}
+
+// ValidationError là báo cáo tổng hợp của Validate, gồm mọi key không resolve được.
+type ValidationError struct {
+	Failures []ValidationFailure
+}
+
+// Error hiện thực error interface, mỗi key lỗi trên một dòng.
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "validation failed for %d binding(s):", len(e.Failures))
+	for _, failure := range e.Failures {
+		fmt.Fprintf(&sb, "\n  - %s [%s]", failure.Key, failure.Kind)
+		if failure.Source != "" {
+			fmt.Fprintf(&sb, " registered at %s", failure.Source)
+		}
+		fmt.Fprintf(&sb, ": %v", failure.Err)
+	}
+	return sb.String()
+}
+
+// Unwrap trả về lỗi của từng key để dùng với errors.Is/errors.As.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure.Err
+	}
+	return errs
+}
+
+// PanicError mô tả factory đã panic khi khởi tạo một key.
+//
+// Được trả về cho các goroutine đang chờ một singleton (hoặc scoped instance) mà factory của nó panic;
+// goroutine gọi factory vẫn nhận lại panic gốc.
+type PanicError struct {
+	Key   string
+	Value interface{}
+}
+
+// Error hiện thực error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("factory for %s panicked: %v", e.Key, e.Value)
+}
+
+// AliasError mô tả alias bị từ chối khi đăng ký vì tạo thành vòng (kể cả alias trỏ tới chính nó).
+//
+//   - Trường:
+//   - Alias: string — alias đang được đăng ký.
+//   - Abstract: string — key mà alias trỏ tới.
+//   - Chain: []string — chuỗi alias khép vòng, bắt đầu và kết thúc bằng Alias.
+type AliasError struct {
+	Alias    string
+	Abstract string
+	Chain    []string
+}
+
+// Error hiện thực error interface.
+func (e *AliasError) Error() string {
+	if e.Alias == e.Abstract {
+		return fmt.Sprintf("%s is aliased to itself", e.Alias)
+	}
+	return fmt.Sprintf("%v: %s", ErrAliasCycle, strings.Join(e.Chain, " -> "))
+}
+
+// Unwrap trả về ErrAliasCycle.
+func (e *AliasError) Unwrap() error {
+	return ErrAliasCycle
+}
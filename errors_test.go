@@ -0,0 +1,116 @@
+package di
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestErrNotFound kiểm tra lỗi thiếu key dùng được với errors.Is/errors.As
+func TestErrNotFound(t *testing.T) {
+	container := New()
+
+	_, err := container.Make("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Make() nên trả về lỗi wrap ErrNotFound, got: %v", err)
+	}
+
+	var resolutionErr *ResolutionError
+	if !errors.As(err, &resolutionErr) || resolutionErr.Key != "missing" {
+		t.Errorf("Make() nên trả về *ResolutionError với Key missing, got: %+v", resolutionErr)
+	}
+}
+
+// TestResolutionErrorWrapsFactoryCause kiểm tra lỗi lồng nhau wrap nguyên nhân gốc
+func TestResolutionErrorWrapsFactoryCause(t *testing.T) {
+	container := New()
+	errConnect := errors.New("connection refused")
+
+	Provide(container, func(c Container) (*MockDependencyA, error) {
+		return nil, errConnect
+	})
+	container.Bind("service", func(c Container) interface{} {
+		return MustResolve[*MockDependencyA](c)
+	})
+
+	_, err := container.Make("service")
+	if !errors.Is(err, errConnect) {
+		t.Errorf("Lỗi nên wrap nguyên nhân gốc của factory, got: %v", err)
+	}
+
+	var resolutionErr *ResolutionError
+	if !errors.As(err, &resolutionErr) {
+		t.Fatalf("Make() nên trả về *ResolutionError, got: %v", err)
+	}
+	if resolutionErr.Key != KeyOf[*MockDependencyA]() || len(resolutionErr.Chain) != 2 {
+		t.Errorf("ResolutionError không trỏ tới factory lỗi: %+v", resolutionErr)
+	}
+}
+
+// TestCallErrors kiểm tra các lỗi có kiểu của Call
+func TestCallErrors(t *testing.T) {
+	container := New()
+
+	if _, err := container.Call("not-a-function"); !errors.Is(err, ErrNotAFunction) {
+		t.Errorf("Call() nên trả về ErrNotAFunction, got: %v", err)
+	}
+
+	if _, err := container.Call(nil); !errors.Is(err, ErrNotAFunction) {
+		t.Errorf("Call(nil) nên trả về ErrNotAFunction, got: %v", err)
+	}
+
+	// Tham số thứ hai không resolve được
+	_, err := container.Call(func(s string, a *MockDependencyA) {}, "given")
+
+	var paramErr *ParameterError
+	if !errors.As(err, &paramErr) {
+		t.Fatalf("Call() nên trả về *ParameterError, got: %v", err)
+	}
+	if paramErr.Index != 1 || paramErr.Type != reflect.TypeOf(&MockDependencyA{}) {
+		t.Errorf("ParameterError chứa thông tin sai: %+v", paramErr)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("ParameterError nên wrap ErrNotFound, got: %v", err)
+	}
+	if err.Error() != "cannot resolve parameter *di.MockDependencyA: bind not found for: *di.MockDependencyA" {
+		t.Errorf("Thông điệp lỗi không đúng: %v", err)
+	}
+
+	// Instance đăng ký không khớp kiểu tham số
+	container.Instance("*di.MockDependencyA", "not-a-dependency")
+	_, err = container.Call(func(a *MockDependencyA) {})
+
+	var mismatch *TypeMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Actual != reflect.TypeOf("") {
+		t.Errorf("Call() nên trả về *TypeMismatchError khi instance sai kiểu, got: %v", err)
+	}
+
+	// Instance nil được inject như zero value, additionalParams nil được bỏ qua
+	container.Instance("*di.MockDependencyA", nil)
+	results, err := container.Call(func(a *MockDependencyA) bool { return a == nil }, nil)
+	if err != nil || results[0] != true {
+		t.Errorf("Call() nên inject zero value cho instance nil: %v, %v", results, err)
+	}
+}
+
+// TestPanicError kiểm tra goroutine chờ singleton nhận *PanicError
+func TestPanicError(t *testing.T) {
+	cont := New().(*container)
+
+	pending := &pendingInstance{done: make(chan struct{})}
+	cont.building["service"] = pending
+
+	go func() {
+		defer func() { _ = recover() }()
+		cont.constructSingleton("service", pending, func(c Container) interface{} {
+			panic("boom")
+		})
+	}()
+
+	<-pending.done
+
+	var panicErr *PanicError
+	if !errors.As(pending.err, &panicErr) || panicErr.Key != "service" || panicErr.Value != "boom" {
+		t.Errorf("Lỗi của singleton panic không đúng: %v", pending.err)
+	}
+}
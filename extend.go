@@ -0,0 +1,165 @@
+package di
+
+import "reflect"
+
+// extender là một decorator đã đăng ký qua Extend.
+type extender struct {
+	// abstract là key (hoặc alias) được decorate.
+	abstract string
+
+	// fn là decorator.
+	fn ExtenderFunc
+
+	// version là phiên bản danh sách decorator (extendVersion) khi decorator được đăng ký.
+	version uint64
+
+	// pending là singleton đang khởi tạo khi Extend được gọi; decorator được áp dụng cho instance đó
+	// trước khi cache (qua pendingInstance.extenders) thay vì qua pipeline của lượt khởi tạo.
+	pending *pendingInstance
+}
+
+// Extend đăng ký decorator cho một abstract đã (hoặc sẽ) được đăng ký.
+//
+//   - Mục đích: Cho phép một provider bọc service do provider khác đăng ký (logging, metrics, caching, ...)
+//     mà không thay thế binding.
+//   - Logic:
+//   - Các decorator được áp dụng chồng lên nhau theo thứ tự đăng ký.
+//   - Binding transient: decorator được áp dụng cho mỗi instance tạo ra.
+//   - Singleton: decorator được áp dụng một lần, trước khi instance được cache; scoped: một lần mỗi scope.
+//   - Instance (kể cả singleton đã được cache): instance hiện có được decorate ngay,
+//     Instance đăng ký sau Extend được decorate khi đăng ký. Nếu instance bị thay thế hoặc xoá
+//     (Instance, ForgetInstance, ...) trong lúc decorator chạy, kết quả bị bỏ qua.
+//   - Singleton đang khởi tạo: decorator được áp dụng cho instance của lượt khởi tạo đó trước khi cache.
+//   - Decorator chạy khi không giữ lock của container, nên có thể resolve dependency hoặc gọi Extend/Instance.
+//   - abstract được so khớp sau khi resolve alias, nên Extend qua alias decorate binding gốc.
+//   - Trên container con, decorator áp dụng cho binding transient và đăng ký của chính container con;
+//     singleton và instance dùng chung của container cha chỉ nhận decorator của container cha.
+//   - Tham số:
+//   - abstract: string — key hoặc alias cần decorate.
+//   - fn: ExtenderFunc — decorator nhận instance và container, trả về instance thay thế.
+func (c *container) Extend(abstract string, fn ExtenderFunc) {
+	key := c.canonical(abstract)
+
+	c.mu.Lock()
+	c.extendVersion++
+	e := extender{abstract: abstract, fn: fn, version: c.extendVersion}
+	if pending, building := c.building[key]; building {
+		e.pending = pending
+		pending.extenders = append(pending.extenders, fn)
+	}
+	c.extenders = append(c.extenders, e)
+	c.mu.Unlock()
+
+	c.decorateInstance(key)
+}
+
+// decorateInstance áp dụng cho instance hiện có của key các decorator chưa được áp dụng cho nó.
+//
+// Decorator chạy khi không giữ lock. Kết quả chỉ được lưu nếu instance không đổi trong lúc decorator chạy;
+// nếu instance bị thay thế, việc decorate được thử lại với instance mới (chỉ với decorator chưa được áp dụng
+// cho nó), còn instance bị xoá thì không được khôi phục.
+func (c *container) decorateInstance(key string) {
+	for {
+		c.mu.RLock()
+		instance, exists := c.instances[key]
+		decoratedVersion := c.decoratedVersions[key]
+		version := c.extendVersion
+		extenders := c.extenders
+		c.mu.RUnlock()
+
+		if !exists {
+			return
+		}
+
+		var fns []ExtenderFunc
+		for _, e := range extenders {
+			if e.version > decoratedVersion && (e.abstract == key || c.canonical(e.abstract) == key) {
+				fns = append(fns, e.fn)
+			}
+		}
+		if len(fns) == 0 {
+			return
+		}
+
+		decorated := applyExtenders(fns, instance, c)
+
+		c.mu.Lock()
+		current, stillExists := c.instances[key]
+		unchanged := stillExists && sameInstance(current, instance) && c.decoratedVersions[key] == decoratedVersion
+		if unchanged {
+			c.instances[key] = decorated
+			c.decoratedVersions[key] = version
+		}
+		c.mu.Unlock()
+
+		if unchanged || !stillExists {
+			return
+		}
+	}
+}
+
+// sameInstance kiểm tra current vẫn là instance previous mà decorator đã nhận.
+//
+// Giá trị không so sánh được bằng ==: map, slice và func được so theo con trỏ, các giá trị khác được coi là không đổi.
+func sameInstance(current, previous interface{}) bool {
+	if current == nil || previous == nil {
+		return current == nil && previous == nil
+	}
+
+	currentValue, previousValue := reflect.ValueOf(current), reflect.ValueOf(previous)
+	if currentValue.Type() != previousValue.Type() {
+		return false
+	}
+	if currentValue.Comparable() {
+		return current == previous
+	}
+
+	switch currentValue.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Func:
+		return currentValue.Pointer() == previousValue.Pointer()
+	}
+	return true
+}
+
+// decorate áp dụng các decorator của abstract cho instance; bindingFailure được trả về nguyên vẹn.
+func (c *container) decorate(abstract string, instance interface{}, view Container) interface{} {
+	return applyExtenders(c.extendersFor(abstract), instance, view)
+}
+
+// extendersFor trả về các decorator của abstract (key gốc), từ container gốc xuống container hiện tại.
+func (c *container) extendersFor(abstract string) []ExtenderFunc {
+	var fns []ExtenderFunc
+	if c.parent != nil {
+		fns = c.parent.extendersFor(abstract)
+	}
+
+	c.mu.RLock()
+	extenders := c.extenders
+	pending := c.building[abstract]
+	c.mu.RUnlock()
+
+	for _, e := range extenders {
+		// Decorator đăng ký trong lúc singleton đang khởi tạo được áp dụng khi lượt khởi tạo đó hoàn tất
+		if e.pending != nil && e.pending == pending {
+			continue
+		}
+		if e.abstract == abstract || c.canonical(e.abstract) == abstract {
+			fns = append(fns, e.fn)
+		}
+	}
+
+	return fns
+}
+
+// applyExtenders áp dụng lần lượt fns cho instance.
+func applyExtenders(fns []ExtenderFunc, instance interface{}, view Container) interface{} {
+	if _, failed := instance.(*bindingFailure); failed {
+		return instance
+	}
+
+	for _, fn := range fns {
+		instance = fn(instance, view)
+	}
+
+	return instance
+}
@@ -0,0 +1,281 @@
+package di
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// decoratedService bọc MockService để kiểm tra decorator
+type decoratedService struct {
+	inner interface{}
+	layer string
+}
+
+// layers trả về các lớp decorator từ ngoài vào trong
+func layers(instance interface{}) []string {
+	var result []string
+	for {
+		decorated, ok := instance.(*decoratedService)
+		if !ok {
+			return result
+		}
+		result = append(result, decorated.layer)
+		instance = decorated.inner
+	}
+}
+
+// wrap tạo decorator thêm một lớp có tên layer
+func wrap(layer string) ExtenderFunc {
+	return func(instance interface{}, c Container) interface{} {
+		return &decoratedService{inner: instance, layer: layer}
+	}
+}
+
+// TestExtendBinding kiểm tra decorator được áp dụng cho binding transient theo thứ tự đăng ký
+func TestExtendBinding(t *testing.T) {
+	container := New()
+
+	calls := 0
+	container.Bind("repository.user", func(c Container) interface{} {
+		calls++
+		return NewMockService("user")
+	})
+	container.Extend("repository.user", wrap("logging"))
+	container.Extend("repository.user", wrap("metrics"))
+
+	first := container.MustMake("repository.user")
+	second := container.MustMake("repository.user")
+
+	if got := layers(first); len(got) != 2 || got[0] != "metrics" || got[1] != "logging" {
+		t.Errorf("Decorator nên chồng theo thứ tự đăng ký, got: %v", got)
+	}
+	if first == second || calls != 2 {
+		t.Error("Binding transient nên được decorate cho mỗi instance")
+	}
+
+	// Decorator áp dụng cho binding đăng ký sau Extend
+	container.Bind("repository.user", func(c Container) interface{} { return NewMockService("replaced") })
+	if got := layers(container.MustMake("repository.user")); len(got) != 2 {
+		t.Errorf("Decorator nên áp dụng cho binding đăng ký lại, got: %v", got)
+	}
+}
+
+// TestExtendSingleton kiểm tra decorator được áp dụng một lần cho singleton
+func TestExtendSingleton(t *testing.T) {
+	container := New()
+
+	decorations := 0
+	container.Singleton("cache", func(c Container) interface{} { return NewMockService("cache") })
+	container.Extend("cache", func(instance interface{}, c Container) interface{} {
+		decorations++
+		return &decoratedService{inner: instance, layer: "metrics"}
+	})
+
+	first := container.MustMake("cache")
+	second := container.MustMake("cache")
+
+	if first != second {
+		t.Error("Singleton đã decorate vẫn nên được chia sẻ")
+	}
+	if decorations != 1 {
+		t.Errorf("Decorator của singleton nên được gọi một lần, got: %d", decorations)
+	}
+
+	// Extend sau khi singleton đã được cache decorate instance đang cache
+	container.Extend("cache", wrap("tracing"))
+	if got := layers(container.MustMake("cache")); len(got) != 2 || got[0] != "tracing" {
+		t.Errorf("Extend nên decorate singleton đã cache, got: %v", got)
+	}
+}
+
+// TestExtendInstanceAndAlias kiểm tra decorator áp dụng cho Instance và qua Alias
+func TestExtendInstanceAndAlias(t *testing.T) {
+	container := New()
+
+	container.Instance("config", NewMockService("config"))
+	container.Alias("config", "cfg")
+	container.Extend("cfg", wrap("validated"))
+
+	if got := layers(container.MustMake("config")); len(got) != 1 || got[0] != "validated" {
+		t.Errorf("Extend qua alias nên decorate instance gốc, got: %v", got)
+	}
+
+	// Instance đăng ký sau Extend được decorate khi đăng ký
+	container.Instance("config", NewMockService("reloaded"))
+	instance := container.MustMake("cfg")
+	if got := layers(instance); len(got) != 1 {
+		t.Errorf("Instance đăng ký sau Extend nên được decorate, got: %v", got)
+	}
+	if instance != container.MustMake("config") {
+		t.Error("Instance đã decorate nên được chia sẻ")
+	}
+
+	// Extend qua alias đăng ký trước Alias vẫn áp dụng cho binding gốc
+	container.Extend("repo", wrap("cached"))
+	container.Bind("repository.order", func(c Container) interface{} { return NewMockService("order") })
+	container.Alias("repository.order", "repo")
+	if got := layers(container.MustMake("repository.order")); len(got) != 1 || got[0] != "cached" {
+		t.Errorf("Extend qua alias đăng ký sau nên decorate binding gốc, got: %v", got)
+	}
+}
+
+// TestExtendScopedAndChild kiểm tra decorator với binding scoped và container con
+func TestExtendScopedAndChild(t *testing.T) {
+	parent := New()
+
+	parent.Scoped("unit.of.work", func(c Container) interface{} { return NewMockService("uow") })
+	parent.Extend("unit.of.work", wrap("audited"))
+
+	scope := parent.NewScope()
+	if first := scope.MustMake("unit.of.work"); first != scope.MustMake("unit.of.work") || len(layers(first)) != 1 {
+		t.Errorf("Binding scoped nên được decorate một lần mỗi scope, got: %v", layers(first))
+	}
+
+	parent.Bind("handler", func(c Container) interface{} { return NewMockService("handler") })
+	child := parent.NewChild()
+	child.Extend("handler", wrap("child"))
+
+	if got := layers(child.MustMake("handler")); len(got) != 1 || got[0] != "child" {
+		t.Errorf("Container con nên decorate binding transient của container cha, got: %v", got)
+	}
+	if got := layers(parent.MustMake("handler")); len(got) != 0 {
+		t.Errorf("Decorator của container con không nên ảnh hưởng container cha, got: %v", got)
+	}
+
+	parent.Reset()
+	parent.Instance("handler", "plain")
+	if parent.MustMake("handler") != "plain" {
+		t.Error("Reset() nên xoá các decorator")
+	}
+}
+
+// TestExtendDuringSingletonBuild kiểm tra Extend trong lúc singleton đang khởi tạo được áp dụng trước khi cache
+func TestExtendDuringSingletonBuild(t *testing.T) {
+	container := New()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	container.Singleton("db", func(c Container) interface{} {
+		once.Do(func() {
+			close(started)
+			<-release
+		})
+		return NewMockService("db")
+	})
+	container.Extend("db", wrap("logging"))
+
+	done := make(chan interface{})
+	go func() {
+		done <- container.MustMake("db")
+	}()
+
+	<-started
+	container.Extend("db", wrap("metrics"))
+	close(release)
+
+	built := <-done
+	if got := layers(built); len(got) != 2 || got[0] != "metrics" || got[1] != "logging" {
+		t.Errorf("Extend trong lúc khởi tạo nên được áp dụng một lần trước khi cache, got: %v", got)
+	}
+	if container.MustMake("db") != built {
+		t.Error("Singleton đã decorate nên được cache")
+	}
+
+	// Decorator vẫn áp dụng cho lần khởi tạo sau
+	container.ForgetInstance("db")
+	if got := layers(container.MustMake("db")); len(got) != 2 {
+		t.Errorf("Decorator nên áp dụng khi singleton được khởi tạo lại, got: %v", got)
+	}
+}
+
+// TestExtendConcurrent kiểm tra Extend đồng thời không làm mất decorator
+func TestExtendConcurrent(t *testing.T) {
+	container := New()
+	container.Instance("service", NewMockService("service"))
+
+	const workers = 20
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			container.Extend("service", wrap(fmt.Sprintf("layer-%d", i)))
+		}(i)
+	}
+	wg.Wait()
+
+	if got := layers(container.MustMake("service")); len(got) != workers {
+		t.Errorf("Mọi decorator nên được áp dụng, got %d: %v", len(got), got)
+	}
+	// Instance đồng thời với Extend: instance cuối cùng được decorate bởi mọi decorator đúng một lần
+	other := New()
+	other.Instance("service", NewMockService("service"))
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			other.Extend("service", wrap(fmt.Sprintf("layer-%d", i)))
+		}(i)
+		go func() {
+			defer wg.Done()
+			other.Instance("service", NewMockService("service"))
+		}()
+	}
+	wg.Wait()
+
+	if got := layers(other.MustMake("service")); len(got) != workers {
+		t.Errorf("Instance đồng thời không nên làm mất hoặc lặp decorator, got %d: %v", len(got), got)
+	}
+}
+
+// TestExtendKeepsNewerInstance kiểm tra Extend không ghi đè instance bị xoá hoặc thay thế trong lúc decorator chạy
+func TestExtendKeepsNewerInstance(t *testing.T) {
+	container := New()
+	container.Instance("config", NewMockService("config"))
+
+	container.Extend("config", func(instance interface{}, c Container) interface{} {
+		c.ForgetInstance("config")
+		return &decoratedService{inner: instance, layer: "late"}
+	})
+
+	if container.Bound("config") {
+		t.Error("Extend không nên khôi phục instance đã bị xoá trong lúc decorator chạy")
+	}
+}
+
+// TestExtendNestedInstance kiểm tra decorator gọi Instance gián tiếp (qua factory singleton) không bị deadlock
+func TestExtendNestedInstance(t *testing.T) {
+	container := New()
+	container.Singleton("y", func(c Container) interface{} {
+		c.Instance("z", 1)
+		return NewMockService("y")
+	})
+	container.Extend("x", func(instance interface{}, c Container) interface{} {
+		c.MustMake("y")
+		return &decoratedService{inner: instance, layer: "nested"}
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		container.Instance("x", NewMockService("x"))
+		container.Extend("x", wrap("outer"))
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Instance/Extend với decorator gọi Instance lồng nhau bị deadlock")
+	}
+
+	if got := layers(container.MustMake("x")); len(got) != 2 || got[0] != "outer" || got[1] != "nested" {
+		t.Errorf("Mọi decorator nên được áp dụng một lần, got: %v", got)
+	}
+	if container.MustMake("z") != 1 {
+		t.Error("Instance đăng ký trong decorator nên được lưu")
+	}
+}
@@ -0,0 +1,162 @@
+package di
+
+// ForgetOption tuỳ chỉnh hành vi của Forget và ForgetInstance.
+type ForgetOption func(*forgetOptions)
+
+// forgetOptions là cấu hình của một lần Forget/ForgetInstance.
+type forgetOptions struct {
+	// invalidateDependents bỏ cache của các singleton được tạo từ key bị xoá.
+	invalidateDependents bool
+}
+
+// InvalidateDependents bỏ cache của các singleton (trực tiếp hoặc gián tiếp) được tạo từ key bị xoá,
+// để lần resolve sau chúng được khởi tạo lại với đăng ký mới.
+//
+// Chỉ singleton của container gọi Forget/ForgetInstance bị bỏ cache; binding transient không có cache,
+// còn singleton của container con được giữ nguyên.
+func InvalidateDependents() ForgetOption {
+	return func(o *forgetOptions) {
+		o.invalidateDependents = true
+	}
+}
+
+// Forget xoá binding và instance của abstract khỏi container.
+//
+//   - Mục đích: Gỡ một module khi hot-swap hoặc cô lập test mà không phải Reset toàn bộ container.
+//   - Logic: Xoá binding, instance (kể cả singleton đã cache) và tag của abstract trên container hiện tại;
+//     alias trỏ tới abstract được giữ nguyên (dùng RemoveAlias để xoá). Với container con,
+//     đăng ký cùng key của container cha không bị ảnh hưởng và lại có hiệu lực.
+//     Instance đăng ký qua Instance bị xoá không còn được Close giải phóng (caller chịu trách nhiệm giải phóng nó);
+//     singleton do container tạo vẫn được giải phóng khi Close.
+//   - Tham số:
+//   - abstract: string — key (hoặc alias) cần xoá.
+//   - opts: ...ForgetOption — InvalidateDependents để bỏ cache các singleton phụ thuộc.
+func (c *container) Forget(abstract string, opts ...ForgetOption) {
+	abstract = c.canonical(abstract)
+
+	c.mu.Lock()
+	c.untrackDisposable(abstract)
+	delete(c.bindings, abstract)
+	delete(c.instances, abstract)
+	delete(c.decoratedVersions, abstract)
+	delete(c.building, abstract)
+	delete(c.lifetimes, abstract)
+	delete(c.contextFactories, abstract)
+	delete(c.paramFactories, abstract)
+	delete(c.resolved, abstract)
+	delete(c.sources, abstract)
+	for tag, abstracts := range c.tags {
+		if remaining := removeString(abstracts, abstract); len(remaining) > 0 {
+			c.tags[tag] = remaining
+		} else {
+			delete(c.tags, tag)
+		}
+	}
+	c.forgetDependencies(abstract)
+	c.mu.Unlock()
+
+	c.invalidate(abstract, opts)
+}
+
+// ForgetInstance xoá instance của abstract, giữ nguyên binding.
+//
+//   - Mục đích: Buộc singleton được khởi tạo lại ở lần resolve sau, hoặc gỡ một instance đăng ký qua Instance.
+//   - Tham số: như Forget.
+func (c *container) ForgetInstance(abstract string, opts ...ForgetOption) {
+	abstract = c.canonical(abstract)
+
+	c.mu.Lock()
+	c.untrackDisposable(abstract)
+	delete(c.instances, abstract)
+	delete(c.building, abstract)
+	if _, isBinding := c.bindings[abstract]; !isBinding {
+		delete(c.sources, abstract)
+	}
+	c.mu.Unlock()
+
+	c.invalidate(abstract, opts)
+}
+
+// RemoveAlias xoá alias khỏi container hiện tại.
+func (c *container) RemoveAlias(alias string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.aliases, alias)
+	delete(c.aliasSources, alias)
+}
+
+// recordDependency ghi nhận consumer đã resolve dependency.
+func (c *container) recordDependency(consumer, dependency string) {
+	c.mu.RLock()
+	_, recorded := c.dependents[dependency][consumer]
+	c.mu.RUnlock()
+
+	if recorded {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.dependents[dependency] == nil {
+		c.dependents[dependency] = make(map[string]struct{})
+	}
+	c.dependents[dependency][consumer] = struct{}{}
+}
+
+// forgetDependencies xoá các dependency đã ghi nhận của consumer; c.mu phải được giữ.
+func (c *container) forgetDependencies(consumer string) {
+	for dependency, consumers := range c.dependents {
+		delete(consumers, consumer)
+		if len(consumers) == 0 {
+			delete(c.dependents, dependency)
+		}
+	}
+}
+
+// invalidate bỏ cache của các singleton phụ thuộc abstract nếu opts yêu cầu.
+func (c *container) invalidate(abstract string, opts []ForgetOption) {
+	var options forgetOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if !options.invalidateDependents {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	visited := map[string]bool{abstract: true}
+	pending := []string{abstract}
+	for len(pending) > 0 {
+		dependency := pending[0]
+		pending = pending[1:]
+
+		for consumer := range c.dependents[dependency] {
+			if visited[consumer] {
+				continue
+			}
+			visited[consumer] = true
+			pending = append(pending, consumer)
+
+			if c.lifetimes[consumer] == lifetimeSingleton {
+				delete(c.instances, consumer)
+				delete(c.building, consumer)
+			}
+		}
+	}
+}
+
+// removeString trả về values không chứa value.
+func removeString(values []string, value string) []string {
+	result := values[:0:0]
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
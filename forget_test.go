@@ -0,0 +1,112 @@
+package di
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestForget kiểm tra Forget xoá binding, instance và tag của abstract
+func TestForget(t *testing.T) {
+	container := New()
+
+	container.Singleton("cache", func(c Container) interface{} { return NewMockService("cache") })
+	container.Instance("config", "value")
+	container.Alias("cache", "store")
+	container.Tag("infra", "cache", "config")
+	container.MustMake("cache")
+
+	container.Forget("store")
+
+	if container.Bound("cache") {
+		t.Error("Forget() nên xoá binding của key gốc")
+	}
+	if _, err := container.Make("store"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Alias trỏ tới key đã xoá nên trả về ErrNotFound, got: %v", err)
+	}
+	if infra, err := container.Tagged("infra"); err != nil || len(infra) != 1 || infra[0] != "value" {
+		t.Errorf("Forget() nên xoá key khỏi tag, got: %v, %v", infra, err)
+	}
+
+	container.RemoveAlias("store")
+	if container.Bound("store") {
+		t.Error("RemoveAlias() nên xoá alias")
+	}
+
+	container.Forget("config")
+	if _, err := container.Tagged("infra"); err != nil || container.Bound("config") {
+		t.Errorf("Forget() nên xoá instance và tag rỗng, got: %v", err)
+	}
+}
+
+// TestForgetInstance kiểm tra ForgetInstance buộc singleton được khởi tạo lại
+func TestForgetInstance(t *testing.T) {
+	container := New()
+
+	calls := 0
+	container.Singleton("connection", func(c Container) interface{} {
+		calls++
+		return NewMockService("connection")
+	})
+
+	first := container.MustMake("connection")
+	container.ForgetInstance("connection")
+	second := container.MustMake("connection")
+
+	if first == second || calls != 2 {
+		t.Errorf("ForgetInstance() nên buộc singleton được khởi tạo lại, calls: %d", calls)
+	}
+	if !container.Bound("connection") {
+		t.Error("ForgetInstance() không nên xoá binding")
+	}
+}
+
+// TestForgetInvalidateDependents kiểm tra bỏ cache các singleton được tạo từ key bị xoá
+func TestForgetInvalidateDependents(t *testing.T) {
+	container := New()
+
+	container.Instance("config", "v1")
+	container.Singleton("db", func(c Container) interface{} {
+		return &MockDependencyB{Value: c.MustMake("config").(string)}
+	})
+	container.Singleton("repository", func(c Container) interface{} {
+		return &MockDependencyA{Value: c.MustMake("db").(*MockDependencyB).Value}
+	})
+	container.Singleton("unrelated", func(c Container) interface{} { return NewMockService("unrelated") })
+
+	container.MustMake("repository")
+	unrelated := container.MustMake("unrelated")
+
+	// Không có option: singleton phụ thuộc vẫn giữ instance cũ
+	container.ForgetInstance("config")
+	container.Instance("config", "v2")
+	if repo := container.MustMake("repository").(*MockDependencyA); repo.Value != "v1" {
+		t.Errorf("Không có InvalidateDependents, singleton phụ thuộc nên giữ cache, got: %s", repo.Value)
+	}
+
+	container.Forget("config", InvalidateDependents())
+	container.Instance("config", "v3")
+
+	if repo := container.MustMake("repository").(*MockDependencyA); repo.Value != "v3" {
+		t.Errorf("InvalidateDependents nên bỏ cache singleton phụ thuộc gián tiếp, got: %s", repo.Value)
+	}
+	if container.MustMake("unrelated") != unrelated {
+		t.Error("Singleton không phụ thuộc không nên bị bỏ cache")
+	}
+}
+
+// TestForgetChild kiểm tra Forget trên container con để lộ lại đăng ký của container cha
+func TestForgetChild(t *testing.T) {
+	parent := New()
+	parent.Instance("config", "parent")
+
+	child := parent.NewChild()
+	child.Instance("config", "child")
+	child.Forget("config")
+
+	if value := child.MustMake("config"); value != "parent" {
+		t.Errorf("Forget() trên container con nên để lộ đăng ký của container cha, got: %v", value)
+	}
+	if !parent.Bound("config") {
+		t.Error("Forget() trên container con không nên ảnh hưởng container cha")
+	}
+}
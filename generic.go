@@ -0,0 +1,198 @@
+package di
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// KeyOf trả về key dùng để đăng ký và resolve một dependency theo kiểu T.
+//
+//   - Mục đích: Cho phép binding theo reflect.Type cùng tồn tại với binding theo string key
+//     trong bindings/instances/aliases của container.
+//   - Logic: Key được dựng từ package path đầy đủ của kiểu (ví dụ: "*go.fork.vn/di.MockService"),
+//     tránh xung đột giữa hai package có cùng tên kiểu. KeyOf[T]() bằng TypeKey của kiểu T.
+//   - Trả về: string key duy nhất cho kiểu T.
+func KeyOf[T any]() string {
+	return TypeKey(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// TypeKey trả về key định danh của kiểu t, như KeyOf nhưng nhận reflect.Type.
+//
+//   - Mục đích: Cho Call, Inject, BindConstructor và code dùng reflection một định danh kiểu không nhập nhằng:
+//     `*config.Config` của hai package khác nhau có hai key khác nhau.
+//   - Logic: Named type dùng package path đầy đủ cộng tên kiểu; pointer, slice, array, map, chan và func
+//     được dựng đệ quy từ kiểu thành phần. Kiểu không có package path (int, error, struct ẩn danh, ...)
+//     dùng t.String().
+//   - Tham số: t: reflect.Type — kiểu cần lấy key.
+//   - Trả về: string — key dùng được với Bind/Instance/Make.
+func TypeKey(t reflect.Type) string {
+	if t.Name() != "" && t.PkgPath() != "" {
+		return t.PkgPath() + "." + t.Name()
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + TypeKey(t.Elem())
+	case reflect.Slice:
+		return "[]" + TypeKey(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), TypeKey(t.Elem()))
+	case reflect.Map:
+		return "map[" + TypeKey(t.Key()) + "]" + TypeKey(t.Elem())
+	case reflect.Chan:
+		switch t.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + TypeKey(t.Elem())
+		case reflect.SendDir:
+			return "chan<- " + TypeKey(t.Elem())
+		}
+		return "chan " + TypeKey(t.Elem())
+	case reflect.Func:
+		return funcKey(t)
+	}
+
+	return t.String()
+}
+
+// funcKey dựng key cho kiểu func chưa đặt tên từ key của các tham số và kết quả.
+func funcKey(t reflect.Type) string {
+	in := make([]string, t.NumIn())
+	for i := range in {
+		in[i] = TypeKey(t.In(i))
+	}
+	if t.IsVariadic() {
+		in[len(in)-1] = "..." + TypeKey(t.In(len(in)-1).Elem())
+	}
+
+	out := make([]string, t.NumOut())
+	for i := range out {
+		out[i] = TypeKey(t.Out(i))
+	}
+
+	key := "func(" + strings.Join(in, ", ") + ")"
+	switch len(out) {
+	case 0:
+		return key
+	case 1:
+		return key + " " + out[0]
+	}
+	return key + " (" + strings.Join(out, ", ") + ")"
+}
+
+// Provide đăng ký một binding (transient) cho kiểu T với factory có kiểu cụ thể.
+//
+//   - Mục đích: Đăng ký dependency theo kiểu thay vì string key, loại bỏ type assertion ở call site.
+//   - Logic: Factory được bọc thành BindingFunc và bind dưới key KeyOf[T]().
+//     Lỗi do factory trả về sẽ được Make/Resolve trả lại cho caller.
+//   - Tham số:
+//   - c: Container — container đích.
+//   - factory: func(Container) (T, error) — factory tạo instance kiểu T.
+func Provide[T any](c Container, factory func(c Container) (T, error)) {
+	c.Bind(KeyOf[T](), typedBinding(factory))
+}
+
+// ProvideSingleton đăng ký singleton cho kiểu T với factory có kiểu cụ thể.
+//
+//   - Mục đích: Như Provide nhưng instance chỉ được khởi tạo một lần.
+//   - Lưu ý: Nếu factory trả về lỗi, instance không được cache và lần resolve sau sẽ gọi lại factory.
+func ProvideSingleton[T any](c Container, factory func(c Container) (T, error)) {
+	c.Singleton(KeyOf[T](), typedBinding(factory))
+}
+
+// ProvideScoped đăng ký binding scoped cho kiểu T với factory có kiểu cụ thể.
+func ProvideScoped[T any](c Container, factory func(c Container) (T, error)) {
+	c.Scoped(KeyOf[T](), typedBinding(factory))
+}
+
+// ProvideInstance đăng ký một instance đã khởi tạo sẵn cho kiểu T.
+func ProvideInstance[T any](c Container, instance T) {
+	c.Instance(KeyOf[T](), instance)
+}
+
+// ProvideValue đăng ký instance dưới key TypeKey của kiểu động của nó.
+//
+//   - Mục đích: Đăng ký giá trị mà kiểu chỉ biết lúc chạy (ví dụ: instance tạo bởi plugin)
+//     để Call, Inject và BindConstructor resolve được theo kiểu.
+//   - Lưu ý: Với instance cần resolve theo kiểu interface, dùng ProvideInstance[I].
+//   - Tham số:
+//   - c: Container — container đích.
+//   - instance: interface{} — giá trị cần đăng ký, khác nil.
+//   - Trả về: string — key đã đăng ký.
+//   - Lỗi: panic với lỗi wrap ErrNilInstance nếu instance là nil, vì không xác định được kiểu để đăng ký.
+func ProvideValue(c Container, instance interface{}) string {
+	if instance == nil {
+		panic(fmt.Errorf("%w: ProvideValue cannot infer the type of nil", ErrNilInstance))
+	}
+
+	key := TypeKey(reflect.TypeOf(instance))
+	c.Instance(key, instance)
+	return key
+}
+
+// Resolve resolve dependency đã đăng ký cho kiểu T.
+//
+//   - Trả về:
+//   - T: instance đã resolve.
+//   - error: lỗi từ Make, hoặc *TypeMismatchError nếu instance không phải kiểu T.
+func Resolve[T any](c Container) (T, error) {
+	return MakeAs[T](c, KeyOf[T]())
+}
+
+// MustResolve resolve dependency cho kiểu T, panic nếu lỗi.
+func MustResolve[T any](c Container) T {
+	instance, err := Resolve[T](c)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// MakeAs resolve một string key và kiểm tra kết quả có kiểu T.
+//
+//   - Mục đích: Dùng cho các binding đăng ký bằng string key nhưng muốn nhận kết quả có kiểu.
+//   - Tham số:
+//   - c: Container — container nguồn.
+//   - abstract: string — key cần resolve.
+//   - Trả về:
+//   - T: instance đã resolve (zero value nếu binding trả về nil).
+//   - error: lỗi từ Make, hoặc *TypeMismatchError nếu instance không phải kiểu T.
+func MakeAs[T any](c Container, abstract string) (T, error) {
+	var zero T
+
+	instance, err := c.Make(abstract)
+	if err != nil {
+		return zero, err
+	}
+
+	if instance == nil {
+		return zero, nil
+	}
+
+	typed, ok := instance.(T)
+	if !ok {
+		return zero, &TypeMismatchError{
+			Key:      abstract,
+			Expected: reflect.TypeOf((*T)(nil)).Elem(),
+			Actual:   reflect.TypeOf(instance),
+		}
+	}
+
+	return typed, nil
+}
+
+// MustMakeAs như MakeAs nhưng panic nếu lỗi.
+func MustMakeAs[T any](c Container, abstract string) T {
+	instance, err := MakeAs[T](c, abstract)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// typedBinding chuyển factory có kiểu thành BindingFunc, lỗi được truyền tới Make như BindingFuncE.
+func typedBinding[T any](factory func(c Container) (T, error)) BindingFunc {
+	return BindingFuncE(func(c Container) (interface{}, error) {
+		return factory(c)
+	}).binding()
+}
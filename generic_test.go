@@ -0,0 +1,216 @@
+package di
+
+import (
+	"errors"
+	htmltemplate "html/template"
+	"reflect"
+	"testing"
+	texttemplate "text/template"
+)
+
+// mockGreeter là interface mẫu dùng để test binding theo kiểu interface
+type mockGreeter interface {
+	Greet() string
+}
+
+func (s *MockService) Greet() string {
+	return "hello " + s.ID
+}
+
+// TestKeyOf kiểm tra key sinh ra từ kiểu
+func TestKeyOf(t *testing.T) {
+	cases := map[string]string{
+		KeyOf[*MockService]():            "*go.fork.vn/di.MockService",
+		KeyOf[MockService]():             "go.fork.vn/di.MockService",
+		KeyOf[[]*MockService]():          "[]*go.fork.vn/di.MockService",
+		KeyOf[map[string]*MockService](): "map[string]*go.fork.vn/di.MockService",
+		KeyOf[mockGreeter]():             "go.fork.vn/di.mockGreeter",
+		KeyOf[int]():                     "int",
+	}
+
+	for got, expected := range cases {
+		if got != expected {
+			t.Errorf("KeyOf() = %s, expected %s", got, expected)
+		}
+	}
+}
+
+// TestTypeKey kiểm tra key của reflect.Type, gồm kiểu func và kiểu trùng tên ở hai package
+func TestTypeKey(t *testing.T) {
+	cases := map[string]string{
+		TypeKey(reflect.TypeOf(func(*MockService, ...string) error { return nil })): "func(*go.fork.vn/di.MockService, ...string) error",
+		TypeKey(reflect.TypeOf(func() (int, *MockService) { return 0, nil })):       "func() (int, *go.fork.vn/di.MockService)",
+		TypeKey(reflect.TypeOf((*texttemplate.Template)(nil))):                      "*text/template.Template",
+		TypeKey(reflect.TypeOf((*htmltemplate.Template)(nil))):                      "*html/template.Template",
+	}
+
+	for got, expected := range cases {
+		if got != expected {
+			t.Errorf("TypeKey() = %s, expected %s", got, expected)
+		}
+	}
+}
+
+// TestCallQualifiedTypes kiểm tra Call phân biệt hai kiểu cùng tên ngắn và fallback về tên ngắn
+func TestCallQualifiedTypes(t *testing.T) {
+	container := New()
+
+	text := texttemplate.New("text")
+	html := htmltemplate.New("html")
+	if key := ProvideValue(container, text); key != "*text/template.Template" {
+		t.Errorf("ProvideValue nên trả về key theo kiểu, got: %s", key)
+	}
+	ProvideValue(container, html)
+
+	results, err := container.Call(func(t *texttemplate.Template, h *htmltemplate.Template) string {
+		return t.Name() + "," + h.Name()
+	})
+	if err != nil || results[0] != "text,html" {
+		t.Errorf("Call nên resolve mỗi kiểu theo package path, got: %v, %v", results, err)
+	}
+
+	// Đăng ký theo tên ngắn vẫn được resolve khi kiểu chưa đăng ký theo package path
+	legacy := New()
+	legacy.Instance("*template.Template", text)
+	results, err = legacy.Call(func(t *texttemplate.Template) string { return t.Name() })
+	if err != nil || results[0] != "text" {
+		t.Errorf("Call nên fallback về tên kiểu ngắn, got: %v, %v", results, err)
+	}
+
+	var mismatch *TypeMismatchError
+	if _, err := legacy.Call(func(h *htmltemplate.Template) {}); !errors.As(err, &mismatch) {
+		t.Errorf("Tên ngắn trỏ tới kiểu khác nên trả về TypeMismatchError, got: %v", err)
+	}
+}
+
+// TestProvideResolve kiểm tra đăng ký và resolve theo kiểu
+func TestProvideResolve(t *testing.T) {
+	container := New()
+
+	calls := 0
+	Provide(container, func(c Container) (*MockService, error) {
+		calls++
+		return NewMockService("typed"), nil
+	})
+
+	service, err := Resolve[*MockService](container)
+	if err != nil {
+		t.Fatalf("Resolve() lỗi: %v", err)
+	}
+	if service.ID != "typed" {
+		t.Errorf("Resolve() trả về instance sai, ID: %s", service.ID)
+	}
+
+	// Binding transient: mỗi lần resolve gọi lại factory
+	MustResolve[*MockService](container)
+	if calls != 2 {
+		t.Errorf("Provide() nên gọi factory mỗi lần resolve, calls: %d", calls)
+	}
+
+	// Binding theo kiểu vẫn truy cập được qua Make với key tương ứng
+	if !container.Bound(KeyOf[*MockService]()) {
+		t.Error("Provide() không đăng ký binding dưới KeyOf[T]()")
+	}
+}
+
+// TestProvideSingleton kiểm tra singleton theo kiểu và factory lỗi không bị cache
+func TestProvideSingleton(t *testing.T) {
+	container := New()
+
+	calls := 0
+	ProvideSingleton(container, func(c Container) (mockGreeter, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("not ready")
+		}
+		return NewMockService("singleton"), nil
+	})
+
+	if _, err := Resolve[mockGreeter](container); err == nil || err.Error() != "not ready" {
+		t.Fatalf("Resolve() nên trả về lỗi từ factory, got: %v", err)
+	}
+
+	first := MustResolve[mockGreeter](container)
+	second := MustResolve[mockGreeter](container)
+
+	if first != second {
+		t.Error("ProvideSingleton() không trả về cùng một instance")
+	}
+	if calls != 2 {
+		t.Errorf("Factory lỗi không nên được cache, calls: %d", calls)
+	}
+}
+
+// TestProvideInstance kiểm tra đăng ký instance theo kiểu
+func TestProvideInstance(t *testing.T) {
+	container := New()
+	service := NewMockService("instance")
+
+	ProvideInstance[mockGreeter](container, service)
+
+	greeter, err := Resolve[mockGreeter](container)
+	if err != nil {
+		t.Fatalf("Resolve() lỗi: %v", err)
+	}
+	if greeter.Greet() != "hello instance" {
+		t.Errorf("Resolve() trả về instance sai: %s", greeter.Greet())
+	}
+}
+
+// TestMakeAs kiểm tra resolve string key có kiểm tra kiểu
+func TestMakeAs(t *testing.T) {
+	container := New()
+	container.Instance("service", NewMockService("string-key"))
+	container.Instance("nil", nil)
+
+	service, err := MakeAs[*MockService](container, "service")
+	if err != nil || service.ID != "string-key" {
+		t.Errorf("MakeAs() không resolve đúng: %v, %v", service, err)
+	}
+
+	// Resolve qua interface mà instance hiện thực
+	if _, err := MakeAs[mockGreeter](container, "service"); err != nil {
+		t.Errorf("MakeAs() nên chấp nhận interface được hiện thực: %v", err)
+	}
+
+	// Kiểu không khớp trả về *TypeMismatchError thay vì panic
+	_, err = MakeAs[*MockDependencyA](container, "service")
+	var mismatch *TypeMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("MakeAs() nên trả về *TypeMismatchError, got: %v", err)
+	}
+	if mismatch.Key != "service" || mismatch.Actual.String() != "*di.MockService" {
+		t.Errorf("TypeMismatchError chứa thông tin sai: %+v", mismatch)
+	}
+
+	// Instance nil trả về zero value
+	if v, err := MakeAs[*MockService](container, "nil"); err != nil || v != nil {
+		t.Errorf("MakeAs() nên trả về zero value cho instance nil: %v, %v", v, err)
+	}
+
+	// Key không tồn tại trả về lỗi của Make
+	if _, err := MakeAs[*MockService](container, "unknown"); err == nil {
+		t.Error("MakeAs() nên trả về lỗi khi key không tồn tại")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustMakeAs() nên panic khi kiểu không khớp")
+		}
+	}()
+	MustMakeAs[*MockDependencyA](container, "service")
+}
+
+// TestProvideValueNil kiểm tra ProvideValue từ chối instance nil với lỗi rõ ràng
+func TestProvideValueNil(t *testing.T) {
+	container := New()
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, ErrNilInstance) {
+			t.Errorf("ProvideValue(nil) nên panic với ErrNilInstance, got: %v", err)
+		}
+	}()
+
+	ProvideValue(container, nil)
+}
@@ -0,0 +1,178 @@
+package di
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DependencyGraph là đồ thị phụ thuộc giữa các key, được ghi nhận khi factory resolve các key khác.
+type DependencyGraph struct {
+	// Nodes là các key đã đăng ký hoặc xuất hiện trong một cạnh, sắp xếp theo key.
+	Nodes []GraphNode `json:"nodes"`
+
+	// Edges là các cạnh từ consumer tới dependency, sắp xếp theo From rồi To.
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode là một key trong DependencyGraph.
+type GraphNode struct {
+	// Key là key đã đăng ký.
+	Key string `json:"key"`
+
+	// Kind là loại đăng ký của key; rỗng nếu key không còn được đăng ký (ví dụ: đã Forget).
+	Kind BindingKind `json:"kind,omitempty"`
+}
+
+// GraphEdge là một cạnh của DependencyGraph.
+type GraphEdge struct {
+	// From là key có factory đã resolve To, hoặc alias trỏ tới To.
+	From string `json:"from"`
+
+	// To là key được resolve (dependency), hoặc key đích của alias.
+	To string `json:"to"`
+
+	// Alias đánh dấu cạnh từ alias tới key đích thay vì cạnh phụ thuộc.
+	Alias bool `json:"alias,omitempty"`
+}
+
+// Graph trả về đồ thị phụ thuộc đã ghi nhận của container.
+//
+//   - Mục đích: Xem lại kiến trúc, phát hiện coupling ngoài dự kiến giữa các module khi code review.
+//   - Logic:
+//   - Mỗi lần factory của key A resolve key B (Make, Call, Inject, ... trong factory), cạnh A -> B được ghi nhận;
+//     key được so khớp sau khi resolve alias. Resolve cấp cao nhất (không nằm trong factory) không tạo cạnh.
+//   - Đồ thị chỉ chứa các cạnh đã thực sự được resolve: factory chưa chạy chưa có cạnh.
+//   - Alias được thể hiện bằng cạnh Alias từ alias tới key đích.
+//   - Container con gộp node và cạnh của container cha.
+//   - Trả về: DependencyGraph — snapshot tại thời điểm gọi; dùng DOT, Mermaid hoặc JSON để xuất.
+func (c *container) Graph() DependencyGraph {
+	graph := DependencyGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+
+	kinds := make(map[string]BindingKind)
+	for _, info := range c.Describe() {
+		kinds[info.Key] = info.Kind
+		if info.Kind == KindAlias {
+			graph.Edges = append(graph.Edges, GraphEdge{From: info.Key, To: info.Alias, Alias: true})
+		}
+	}
+
+	seen := make(map[GraphEdge]bool)
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		for dependency, consumers := range current.dependents {
+			for consumer := range consumers {
+				edge := GraphEdge{From: consumer, To: dependency}
+				if !seen[edge] {
+					seen[edge] = true
+					graph.Edges = append(graph.Edges, edge)
+				}
+			}
+		}
+		current.mu.RUnlock()
+	}
+
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+
+	keys := make(map[string]bool, len(kinds))
+	for key := range kinds {
+		keys[key] = true
+	}
+	for _, edge := range graph.Edges {
+		keys[edge.From] = true
+		keys[edge.To] = true
+	}
+	for key := range keys {
+		graph.Nodes = append(graph.Nodes, GraphNode{Key: key, Kind: kinds[key]})
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Key < graph.Nodes[j].Key
+	})
+
+	return graph
+}
+
+// DOT xuất đồ thị theo định dạng Graphviz DOT.
+//
+// Singleton và instance được vẽ bằng hình hộp, binding scoped bằng hình bầu dục nét đứt, alias bằng cạnh nét đứt.
+func (g DependencyGraph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph dependencies {\n")
+	sb.WriteString("    rankdir=LR;\n")
+
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&sb, "    %s", dotQuote(node.Key))
+		switch node.Kind {
+		case KindSingleton, KindInstance:
+			sb.WriteString(" [shape=box]")
+		case KindScoped:
+			sb.WriteString(" [style=dashed]")
+		}
+		sb.WriteString(";\n")
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "    %s -> %s", dotQuote(edge.From), dotQuote(edge.To))
+		if edge.Alias {
+			sb.WriteString(" [style=dashed]")
+		}
+		sb.WriteString(";\n")
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid xuất đồ thị theo định dạng Mermaid flowchart (graph TD), như các sơ đồ trong docs/.
+//
+// Binding transient, scoped và alias được vẽ bằng hình bo tròn, các key khác bằng hình hộp; alias bằng cạnh nét đứt.
+func (g DependencyGraph) Mermaid() string {
+	ids := make(map[string]string, len(g.Nodes))
+
+	var sb strings.Builder
+	sb.WriteString("graph TD\n")
+
+	for i, node := range g.Nodes {
+		id := fmt.Sprintf("N%d", i)
+		ids[node.Key] = id
+
+		label := mermaidQuote(node.Key)
+		switch node.Kind {
+		case KindSingleton, KindInstance, "":
+			fmt.Fprintf(&sb, "    %s[%s]\n", id, label)
+		default:
+			fmt.Fprintf(&sb, "    %s(%s)\n", id, label)
+		}
+	}
+
+	for _, edge := range g.Edges {
+		arrow := "-->"
+		if edge.Alias {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&sb, "    %s %s %s\n", ids[edge.From], arrow, ids[edge.To])
+	}
+
+	return sb.String()
+}
+
+// JSON xuất đồ thị dưới dạng JSON có thụt lề: {"nodes": [...], "edges": [...]}.
+func (g DependencyGraph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// dotQuote đặt key trong dấu nháy kép của DOT.
+func dotQuote(key string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(key) + `"`
+}
+
+// mermaidQuote đặt key trong dấu nháy kép của Mermaid; dấu nháy kép trong key được thay bằng #quot;.
+func mermaidQuote(key string) string {
+	return `"` + strings.ReplaceAll(key, `"`, "#quot;") + `"`
+}
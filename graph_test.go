@@ -0,0 +1,120 @@
+package di
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// setupGraphContainer tạo container có chuỗi phụ thuộc handler -> service -> db, cache
+func setupGraphContainer() Container {
+	container := New()
+
+	container.Singleton("db", func(c Container) interface{} { return NewMockService("db") })
+	container.Instance("cache", NewMockService("cache"))
+	container.Alias("db", "database")
+	container.Scoped("request", func(c Container) interface{} { return NewMockService("request") })
+	container.Bind("service", func(c Container) interface{} {
+		c.MustMake("database")
+		c.MustMake("cache")
+		return NewMockService("service")
+	})
+	container.Bind("handler", func(c Container) interface{} {
+		return c.MustMake("service")
+	})
+
+	container.MustMake("handler")
+	return container
+}
+
+// TestGraph kiểm tra node và cạnh được ghi nhận
+func TestGraph(t *testing.T) {
+	graph := setupGraphContainer().Graph()
+
+	expectedEdges := []GraphEdge{
+		{From: "database", To: "db", Alias: true},
+		{From: "handler", To: "service"},
+		{From: "service", To: "cache"},
+		{From: "service", To: "db"},
+	}
+	if len(graph.Edges) != len(expectedEdges) {
+		t.Fatalf("Graph nên có %d cạnh, got: %v", len(expectedEdges), graph.Edges)
+	}
+	for i := range expectedEdges {
+		if graph.Edges[i] != expectedEdges[i] {
+			t.Errorf("Edges[%d] = %+v, expected %+v", i, graph.Edges[i], expectedEdges[i])
+		}
+	}
+
+	kinds := map[string]BindingKind{}
+	for _, node := range graph.Nodes {
+		kinds[node.Key] = node.Kind
+	}
+	if len(kinds) != 6 || kinds["db"] != KindSingleton || kinds["request"] != KindScoped || kinds["database"] != KindAlias {
+		t.Errorf("Node không khớp đăng ký, got: %v", graph.Nodes)
+	}
+
+	// Container con gộp cạnh của container cha và ghi nhận cạnh của chính nó
+	child := setupGraphContainer().NewChild()
+	child.Bind("job", func(c Container) interface{} { return c.MustMake("handler") })
+	child.MustMake("job")
+	edges := child.Graph().Edges
+	if len(edges) != 5 || edges[2] != (GraphEdge{From: "job", To: "handler"}) {
+		t.Errorf("Graph của container con nên gộp cạnh của container cha, got: %v", edges)
+	}
+}
+
+// TestGraphExport kiểm tra xuất DOT, Mermaid và JSON
+func TestGraphExport(t *testing.T) {
+	graph := setupGraphContainer().Graph()
+
+	dot := graph.DOT()
+	for _, line := range []string{
+		"digraph dependencies {",
+		`    "db" [shape=box];`,
+		`    "request" [style=dashed];`,
+		`    "service" -> "db";`,
+		`    "database" -> "db" [style=dashed];`,
+	} {
+		if !strings.Contains(dot, line+"\n") {
+			t.Errorf("DOT nên chứa %q, got:\n%s", line, dot)
+		}
+	}
+
+	mermaid := graph.Mermaid()
+	// Node được đánh số theo thứ tự key: cache, database, db, handler, request, service
+	for _, line := range []string{
+		"graph TD",
+		`    N2["db"]`,
+		`    N5("service")`,
+		"    N5 --> N2",
+		"    N1 -.-> N2",
+	} {
+		if !strings.Contains(mermaid, line+"\n") {
+			t.Errorf("Mermaid nên chứa %q, got:\n%s", line, mermaid)
+		}
+	}
+
+	data, err := graph.JSON()
+	if err != nil {
+		t.Fatalf("JSON failed: %v", err)
+	}
+	var decoded DependencyGraph
+	if err := json.Unmarshal(data, &decoded); err != nil || len(decoded.Nodes) != len(graph.Nodes) || len(decoded.Edges) != len(graph.Edges) {
+		t.Errorf("JSON nên giữ nguyên node và cạnh, got: %s, %v", data, err)
+	}
+	if !strings.Contains(string(data), `"from": "service"`) {
+		t.Errorf("JSON nên dùng tên field from/to, got: %s", data)
+	}
+
+	empty, _ := New().Graph().JSON()
+	if string(empty) != "{\n  \"nodes\": [],\n  \"edges\": []\n}" {
+		t.Errorf("Graph rỗng nên xuất mảng rỗng, got: %s", empty)
+	}
+
+	// Ký tự đặc biệt trong key được escape
+	quoted := DependencyGraph{Nodes: []GraphNode{{Key: `a"b`}}}
+	if !strings.Contains(quoted.DOT(), `"a\"b"`) || !strings.Contains(quoted.Mermaid(), `"a#quot;b"`) {
+		t.Errorf("Key chứa dấu nháy nên được escape, got:\n%s\n%s", quoted.DOT(), quoted.Mermaid())
+	}
+}
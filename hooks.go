@@ -0,0 +1,155 @@
+package di
+
+// ResolvingFunc là hook được gọi khi một instance vừa được factory tạo ra.
+//
+// abstract là key gốc (đã resolve alias) của instance, c là container của lượt resolve.
+type ResolvingFunc func(abstract string, instance interface{}, c Container)
+
+// resolvingHook là một hook đã đăng ký qua Resolving/AfterResolving/ResolvingAny/AfterResolvingAny.
+type resolvingHook struct {
+	// abstract là key (hoặc alias) mà hook theo dõi; bị bỏ qua khi global là true.
+	abstract string
+
+	// global đánh dấu hook áp dụng cho mọi abstract.
+	global bool
+
+	// after đánh dấu hook AfterResolving.
+	after bool
+
+	// fn là hook.
+	fn ResolvingFunc
+}
+
+// Resolving đăng ký hook được gọi mỗi khi abstract được factory tạo ra.
+//
+//   - Mục đích: Inject logger, chạy validation, đăng ký instance với health registry, ... mà không sửa factory.
+//   - Logic:
+//   - Hook được gọi sau khi các decorator (Extend) đã được áp dụng, theo thứ tự đăng ký:
+//     hook Resolving của mọi abstract, hook Resolving của abstract, rồi tương tự với AfterResolving.
+//   - Binding transient: hook được gọi mỗi lần resolve; singleton: chỉ lần khởi tạo đầu tiên;
+//     scoped: một lần mỗi scope. Instance đăng ký qua Instance không kích hoạt hook.
+//   - Hook được gọi trước khi singleton được cache, nên cần dùng Container nhận được qua tham số
+//     để resolve dependency khác.
+//   - abstract được so khớp sau khi resolve alias.
+//   - Tham số:
+//   - abstract: string — key hoặc alias cần theo dõi.
+//   - fn: ResolvingFunc — hook nhận key, instance và container.
+func (c *container) Resolving(abstract string, fn ResolvingFunc) {
+	c.addHook(resolvingHook{abstract: abstract, fn: fn})
+}
+
+// AfterResolving đăng ký hook được gọi sau mọi hook Resolving của abstract.
+//
+//   - Logic: Như Resolving; hook AfterResolving luôn chạy sau các hook Resolving của cùng instance.
+func (c *container) AfterResolving(abstract string, fn ResolvingFunc) {
+	c.addHook(resolvingHook{abstract: abstract, after: true, fn: fn})
+}
+
+// ResolvingAny đăng ký hook Resolving cho mọi abstract.
+func (c *container) ResolvingAny(fn ResolvingFunc) {
+	c.addHook(resolvingHook{global: true, fn: fn})
+}
+
+// AfterResolvingAny đăng ký hook AfterResolving cho mọi abstract.
+func (c *container) AfterResolvingAny(fn ResolvingFunc) {
+	c.addHook(resolvingHook{global: true, after: true, fn: fn})
+}
+
+// addHook lưu hook theo thứ tự đăng ký.
+func (c *container) addHook(hook resolvingHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.hooks = append(c.hooks, hook)
+}
+
+// buildPipeline là các bước áp dụng cho instance vừa được factory tạo ra.
+type buildPipeline struct {
+	extenders      []ExtenderFunc
+	resolving      []ResolvingFunc
+	afterResolving []ResolvingFunc
+}
+
+// pipeline trả về các decorator và hook của abstract (key gốc), từ container gốc xuống container hiện tại.
+func (c *container) pipeline(abstract string) buildPipeline {
+	p := buildPipeline{extenders: c.extendersFor(abstract)}
+
+	hooks := c.hooksFor(abstract)
+	for _, after := range []bool{false, true} {
+		for _, global := range []bool{true, false} {
+			for _, hook := range hooks {
+				if hook.after != after || hook.global != global {
+					continue
+				}
+				if after {
+					p.afterResolving = append(p.afterResolving, hook.fn)
+				} else {
+					p.resolving = append(p.resolving, hook.fn)
+				}
+			}
+		}
+	}
+
+	return p
+}
+
+// hooksFor trả về các hook áp dụng cho abstract (key gốc), từ container gốc xuống container hiện tại.
+func (c *container) hooksFor(abstract string) []resolvingHook {
+	var matched []resolvingHook
+	if c.parent != nil {
+		matched = c.parent.hooksFor(abstract)
+	}
+
+	c.mu.RLock()
+	hooks := c.hooks
+	c.mu.RUnlock()
+
+	for _, hook := range hooks {
+		if hook.global || hook.abstract == abstract || c.canonical(hook.abstract) == abstract {
+			matched = append(matched, hook)
+		}
+	}
+
+	return matched
+}
+
+// empty kiểm tra pipeline không có bước nào.
+func (p buildPipeline) empty() bool {
+	return len(p.extenders) == 0 && len(p.resolving) == 0 && len(p.afterResolving) == 0
+}
+
+// apply áp dụng decorator rồi gọi hook cho instance; bindingFailure được trả về nguyên vẹn.
+//
+// Hook không được gọi cho instance tạm của Validate, để chúng không giữ instance sẽ bị giải phóng.
+func (p buildPipeline) apply(abstract string, instance interface{}, view Container) interface{} {
+	instance = applyExtenders(p.extenders, instance, view)
+	if _, failed := instance.(*bindingFailure); failed || validationOf(view) != nil {
+		return instance
+	}
+
+	for _, fn := range p.resolving {
+		fn(abstract, instance, view)
+	}
+	for _, fn := range p.afterResolving {
+		fn(abstract, instance, view)
+	}
+
+	return instance
+}
+
+// complete áp dụng decorator và gọi hook cho instance vừa được factory của abstract tạo ra.
+func (c *container) complete(abstract string, instance interface{}, view Container) interface{} {
+	return c.pipeline(abstract).apply(abstract, instance, view)
+}
+
+// completed trả về concrete được bọc bởi decorator và hook của abstract, hoặc concrete nếu không có bước nào.
+func (c *container) completed(abstract string, concrete BindingFunc) BindingFunc {
+	p := c.pipeline(abstract)
+	if p.empty() {
+		return concrete
+	}
+
+	return func(view Container) interface{} {
+		return p.apply(abstract, concrete(view), view)
+	}
+}
@@ -0,0 +1,132 @@
+package di
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// TestResolvingHooks kiểm tra thứ tự gọi hook Resolving và AfterResolving
+func TestResolvingHooks(t *testing.T) {
+	container := New()
+
+	container.Bind("mailer", func(c Container) interface{} { return NewMockService("mailer") })
+	container.Alias("mailer", "mail")
+
+	var events []string
+	record := func(event string) ResolvingFunc {
+		return func(abstract string, instance interface{}, c Container) {
+			events = append(events, event+":"+abstract+":"+instance.(*MockService).ID)
+		}
+	}
+
+	container.AfterResolving("mail", record("after"))
+	container.Resolving("mailer", record("resolving"))
+	container.AfterResolvingAny(record("after-any"))
+	container.ResolvingAny(record("resolving-any"))
+
+	container.MustMake("mail")
+
+	expected := []string{
+		"resolving-any:mailer:mailer",
+		"resolving:mailer:mailer",
+		"after-any:mailer:mailer",
+		"after:mailer:mailer",
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("Hook được gọi %d lần, expected %d: %v", len(events), len(expected), events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("events[%d] = %s, expected %s", i, events[i], expected[i])
+		}
+	}
+
+	// Binding transient kích hoạt hook mỗi lần resolve
+	container.MustMake("mailer")
+	if len(events) != 2*len(expected) {
+		t.Errorf("Binding transient nên kích hoạt hook mỗi lần resolve, got: %d", len(events))
+	}
+}
+
+// TestResolvingHooksSingleton kiểm tra hook của singleton chỉ được gọi lần khởi tạo đầu tiên
+func TestResolvingHooksSingleton(t *testing.T) {
+	container := New()
+
+	container.Singleton("db", func(c Container) interface{} { return NewMockService("db") })
+	container.Extend("db", wrap("pooled"))
+
+	var mu sync.Mutex
+	calls := 0
+	container.Resolving("db", func(abstract string, instance interface{}, c Container) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if len(layers(instance)) != 1 {
+			t.Error("Hook nên nhận instance đã được decorate")
+		}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			container.MustMake("db")
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Hook của singleton nên được gọi một lần, got: %d", calls)
+	}
+
+	// Instance không được tạo bởi factory nên không kích hoạt hook
+	container.Instance("config", "value")
+	container.ResolvingAny(func(abstract string, instance interface{}, c Container) {
+		t.Errorf("Instance không nên kích hoạt hook, got: %s", abstract)
+	})
+	container.MustMake("config")
+	container.MustMake("db")
+}
+
+// TestResolvingHooksScopeAndErrors kiểm tra hook với binding scoped và factory lỗi
+func TestResolvingHooksScopeAndErrors(t *testing.T) {
+	parent := New()
+
+	resolved := map[string]int{}
+	parent.ResolvingAny(func(abstract string, instance interface{}, c Container) {
+		resolved[abstract]++
+	})
+
+	parent.Scoped("request", func(c Container) interface{} { return NewMockService("request") })
+	parent.BindE("broken", func(c Container) (interface{}, error) { return nil, errors.New("broken") })
+
+	scope := parent.NewScope()
+	scope.MustMake("request")
+	scope.MustMake("request")
+	parent.NewScope().MustMake("request")
+
+	if resolved["request"] != 2 {
+		t.Errorf("Hook của binding scoped nên được gọi một lần mỗi scope, got: %d", resolved["request"])
+	}
+
+	if _, err := parent.Make("broken"); err == nil || resolved["broken"] != 0 {
+		t.Error("Factory lỗi không nên kích hoạt hook")
+	}
+
+	// Hook của container cha áp dụng cho binding của container con
+	child := parent.NewChild()
+	child.Bind("child.service", func(c Container) interface{} { return "child" })
+	child.MustMake("child.service")
+	if resolved["child.service"] != 1 {
+		t.Errorf("Hook của container cha nên áp dụng cho container con, got: %d", resolved["child.service"])
+	}
+
+	parent.Reset()
+	parent.Bind("after.reset", func(c Container) interface{} { return "value" })
+	parent.MustMake("after.reset")
+	if resolved["after.reset"] != 0 {
+		t.Error("Reset() nên xoá các hook")
+	}
+}
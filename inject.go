@@ -0,0 +1,214 @@
+package di
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// injectTag là tên struct tag dùng để cấu hình field injection.
+const injectTag = "di"
+
+// errorType là reflect.Type của error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// fieldSpec là cấu hình injection của một field, đọc từ tag `di:"key,optional,lazy"`.
+type fieldSpec struct {
+	// key là key cần resolve; rỗng nghĩa là resolve theo kiểu.
+	key string
+
+	// optional bỏ qua field khi key chưa được đăng ký.
+	optional bool
+
+	// lazy gán cho field một hàm resolve dependency khi được gọi.
+	lazy bool
+}
+
+// parseFieldSpec đọc tag di của field; skip là true nếu field không được inject.
+func parseFieldSpec(field reflect.StructField) (spec fieldSpec, tagged bool, skip bool) {
+	tag, tagged := field.Tag.Lookup(injectTag)
+	if tag == "-" {
+		return spec, tagged, true
+	}
+
+	parts := strings.Split(tag, ",")
+	spec.key = strings.TrimSpace(parts[0])
+	for _, option := range parts[1:] {
+		switch strings.TrimSpace(option) {
+		case "optional":
+			spec.optional = true
+		case "lazy":
+			spec.lazy = true
+		}
+	}
+
+	return spec, tagged, false
+}
+
+// Inject điền các field exported của struct mà target trỏ tới bằng dependency từ container.
+//
+//   - Mục đích: Thay thế việc viết tay `MustMake(...).(*X)` cho từng field trong provider.
+//   - Logic:
+//   - Field có tag `di:"key"` được resolve theo key; tag `di:""` (không có key) resolve theo kiểu của field.
+//   - Field exported không có tag được resolve theo kiểu nếu kiểu đó đã được đăng ký, nếu không được giữ nguyên.
+//   - Resolve theo kiểu dùng KeyOf (package path đầy đủ), sau đó tới tên kiểu ngắn (reflect.Type.String()).
+//   - Option `optional`: bỏ qua field nếu key chưa được đăng ký.
+//   - Option `lazy`: field kiểu func() T hoặc func() (T, error) nhận một hàm resolve dependency khi được gọi,
+//     với context và scope của lượt Inject; dạng func() T panic nếu resolve lỗi. Field lazy luôn được gán,
+//     kể cả khi có `optional` và key chưa được đăng ký: hàm trả về lỗi wrap ErrNotFound nếu key vẫn chưa được
+//     đăng ký khi được gọi.
+//   - Tag `di:"-"` bỏ qua field.
+//   - Tham số:
+//   - target: interface{} — con trỏ tới struct cần inject.
+//   - Trả về: error — ErrInvalidInjectTarget nếu target không phải con trỏ tới struct;
+//     *FieldError (wrap lỗi resolve gốc hoặc *TypeMismatchError) nếu không inject được một field.
+func (c *container) Inject(target interface{}) error {
+	return c.inject(newResolveState(context.Background()), target)
+}
+
+// Inject điền các field của target với dependency resolve trong chuỗi resolve hiện tại.
+func (r *resolver) Inject(target interface{}) error {
+	return r.inject(r.state, target)
+}
+
+// inject là hiện thực nội bộ của Inject.
+func (c *container) inject(state resolveState, target interface{}) error {
+	value := reflect.ValueOf(target)
+	if !value.IsValid() || value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w, got %T", ErrInvalidInjectTarget, target)
+	}
+
+	structValue := value.Elem()
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		spec, tagged, skip := parseFieldSpec(field)
+		if skip || (!tagged && !field.IsExported()) {
+			continue
+		}
+		if !field.IsExported() {
+			return &FieldError{Field: field.Name, Type: field.Type, Cause: ErrUnexportedField}
+		}
+
+		dependencyType := field.Type
+		if spec.lazy {
+			if !isLazyType(field.Type) {
+				return &FieldError{Field: field.Name, Type: field.Type, Cause: ErrInvalidLazyField}
+			}
+			dependencyType = field.Type.Out(0)
+		}
+
+		key := spec.key
+		if key == "" {
+			key = c.typeAbstract(dependencyType)
+		}
+
+		// Field không có tag chỉ được inject khi kiểu của nó đã được đăng ký; field lazy resolve khi được gọi
+		if !spec.lazy && (!tagged || spec.optional) && !c.Bound(key) {
+			continue
+		}
+
+		if spec.lazy {
+			structValue.Field(i).Set(c.lazyValue(state, key, field.Type))
+			continue
+		}
+
+		instance, err := c.resolveValue(state, key, dependencyType)
+		if err != nil {
+			return &FieldError{Field: field.Name, Type: field.Type, Cause: err}
+		}
+		structValue.Field(i).Set(instance)
+	}
+
+	return nil
+}
+
+// typeAbstract trả về key dùng để resolve một dependency theo kiểu t.
+//
+// Key TypeKey (package path đầy đủ) được ưu tiên; nếu chưa được đăng ký, dùng tên kiểu ngắn (t.String())
+// để tương thích với đăng ký cũ như Instance("*config.Config", cfg).
+func (c *container) typeAbstract(t reflect.Type) string {
+	return c.qualifiedAbstract(TypeKey(t), t.String())
+}
+
+// qualifiedAbstract chọn key (TypeKey) nếu đã được đăng ký hoặc trùng tên ngắn, nếu không chọn shortKey.
+func (c *container) qualifiedAbstract(key, shortKey string) string {
+	if key != shortKey && !c.Bound(key) {
+		return shortKey
+	}
+	return key
+}
+
+// resolveValue resolve abstract và kiểm tra instance gán được cho kiểu t; instance nil trả về zero value của t.
+func (c *container) resolveValue(state resolveState, abstract string, t reflect.Type) (reflect.Value, error) {
+	instance, err := c.resolveParam(state, abstract)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	instanceValue := reflect.ValueOf(instance)
+	switch {
+	case !instanceValue.IsValid():
+		return reflect.Zero(t), nil
+	case !instanceValue.Type().AssignableTo(t):
+		return reflect.Value{}, &TypeMismatchError{Key: abstract, Expected: t, Actual: instanceValue.Type()}
+	}
+
+	return instanceValue, nil
+}
+
+// isLazyType kiểm tra t có dạng func() T hoặc func() (T, error).
+func isLazyType(t reflect.Type) bool {
+	return isConstructorType(t) && t.NumIn() == 0
+}
+
+// lazyValue tạo hàm kiểu t resolve abstract mỗi khi được gọi.
+//
+// Hàm mới giữ scope và context của lượt Inject nhưng bắt đầu một chuỗi resolve mới,
+// vì nó có thể được gọi sau khi lượt resolve hiện tại đã kết thúc.
+func (c *container) lazyValue(state resolveState, abstract string, t reflect.Type) reflect.Value {
+	lazyState := resolveState{ctx: state.ctx, scope: state.scope}
+	elemType := t.Out(0)
+
+	return reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value {
+		instance, err := c.resolveValue(lazyState, abstract, elemType)
+		if t.NumOut() == 1 {
+			if err != nil {
+				panic(err)
+			}
+			return []reflect.Value{instance}
+		}
+
+		errValue := reflect.Zero(errorType)
+		if err != nil {
+			instance = reflect.Zero(elemType)
+			errValue = reflect.ValueOf(&err).Elem()
+		}
+		return []reflect.Value{instance, errValue}
+	})
+}
+
+// MakeStruct cấp phát một struct T và inject các field của nó.
+//
+//   - Tham số: c: Container — container nguồn.
+//   - Trả về:
+//   - *T: struct đã được inject.
+//   - error: lỗi của Inject; ErrInvalidInjectTarget nếu T không phải struct.
+func MakeStruct[T any](c Container) (*T, error) {
+	target := new(T)
+	if err := c.Inject(target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// MustMakeStruct như MakeStruct nhưng panic nếu lỗi.
+func MustMakeStruct[T any](c Container) *T {
+	target, err := MakeStruct[T](c)
+	if err != nil {
+		panic(err)
+	}
+	return target
+}
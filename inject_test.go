@@ -0,0 +1,200 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// mailer là dependency dùng trong các test Inject
+type mailer struct {
+	From string
+}
+
+// notifier là struct được inject trong các test Inject
+type notifier struct {
+	Mailer   *mailer                 `di:"mailer"`
+	Service  *MockService            `di:""`
+	Logger   *MockService            `di:"logger,optional"`
+	Lazy     func() *mailer          `di:"mailer,lazy"`
+	LazyErr  func() (*mailer, error) `di:"mailer,lazy"`
+	Config   map[string]string
+	Skipped  *mailer `di:"-"`
+	internal *mailer
+}
+
+// TestInject kiểm tra inject field theo tag, theo kiểu và các option
+func TestInject(t *testing.T) {
+	container := New()
+
+	calls := 0
+	container.Bind("mailer", func(c Container) interface{} {
+		calls++
+		return &mailer{From: "noreply"}
+	})
+	ProvideInstance(container, NewMockService("typed"))
+
+	var n notifier
+	if err := container.Inject(&n); err != nil {
+		t.Fatalf("Inject failed: %v", err)
+	}
+
+	if n.Mailer == nil || n.Mailer.From != "noreply" {
+		t.Errorf("Field có tag di nên được resolve theo key, got: %v", n.Mailer)
+	}
+	if n.Service == nil || n.Service.ID != "typed" {
+		t.Errorf("Field có tag rỗng nên được resolve theo kiểu, got: %v", n.Service)
+	}
+	if n.Logger != nil {
+		t.Error("Field optional chưa đăng ký nên được giữ nguyên")
+	}
+	if n.Config != nil || n.Skipped != nil || n.internal != nil {
+		t.Error("Field không tag chưa đăng ký, field `-` và field unexported nên được giữ nguyên")
+	}
+
+	if calls != 1 {
+		t.Errorf("Field lazy không nên resolve trước khi được gọi, calls: %d", calls)
+	}
+	if m := n.Lazy(); m == nil || m.From != "noreply" || calls != 2 {
+		t.Errorf("Field lazy nên resolve khi được gọi, got: %v, calls: %d", m, calls)
+	}
+	if m, err := n.LazyErr(); err != nil || m == nil {
+		t.Errorf("Field lazy dạng (T, error) nên trả về instance, got: %v, %v", m, err)
+	}
+
+	// Field không tag được inject khi kiểu đã được đăng ký
+	container.Instance("map[string]string", map[string]string{"env": "test"})
+	n = notifier{}
+	if err := container.Inject(&n); err != nil || n.Config["env"] != "test" {
+		t.Errorf("Field không tag nên được resolve theo kiểu đã đăng ký, got: %v, %v", n.Config, err)
+	}
+
+	// Lazy dạng (T, error) trả về lỗi sau khi binding bị xoá
+	container.Forget("mailer")
+	if _, err := n.LazyErr(); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Field lazy nên trả về lỗi resolve, got: %v", err)
+	}
+}
+
+// tenantConsumer nhận tenant qua field lazy
+type tenantConsumer struct {
+	Tenant  func() (string, error) `di:"tenant,lazy"`
+	Missing func() (string, error) `di:"missing,optional,lazy"`
+}
+
+// TestInjectLazyContext kiểm tra field lazy giữ context của lượt Inject và field optional lazy chưa đăng ký
+func TestInjectLazyContext(t *testing.T) {
+	container := New()
+
+	container.BindContext("tenant", func(ctx context.Context, c Container) (interface{}, error) {
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		return tenant, nil
+	})
+	container.BindE("consumer", func(c Container) (interface{}, error) {
+		consumer := &tenantConsumer{}
+		return consumer, c.Inject(consumer)
+	})
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	instance, err := container.MakeContext(ctx, "consumer")
+	if err != nil {
+		t.Fatalf("MakeContext failed: %v", err)
+	}
+	consumer := instance.(*tenantConsumer)
+
+	if tenant, err := consumer.Tenant(); err != nil || tenant != "acme" {
+		t.Errorf("Field lazy nên resolve với context của lượt Inject, got: %q, %v", tenant, err)
+	}
+
+	if consumer.Missing == nil {
+		t.Fatal("Field optional lazy chưa đăng ký nên được gán")
+	}
+	if _, err := consumer.Missing(); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Field optional lazy chưa đăng ký nên trả về ErrNotFound, got: %v", err)
+	}
+
+	container.Instance("missing", "registered later")
+	if value, err := consumer.Missing(); err != nil || value != "registered later" {
+		t.Errorf("Field optional lazy nên resolve key đăng ký sau Inject, got: %q, %v", value, err)
+	}
+}
+
+// TestInjectErrors kiểm tra các lỗi của Inject
+func TestInjectErrors(t *testing.T) {
+	container := New()
+	container.Instance("mailer", "not a mailer")
+
+	var n notifier
+	if err := container.Inject(n); !errors.Is(err, ErrInvalidInjectTarget) {
+		t.Errorf("Target không phải con trỏ nên trả về ErrInvalidInjectTarget, got: %v", err)
+	}
+	if err := container.Inject((*notifier)(nil)); !errors.Is(err, ErrInvalidInjectTarget) {
+		t.Errorf("Con trỏ nil nên trả về ErrInvalidInjectTarget, got: %v", err)
+	}
+
+	err := container.Inject(&n)
+	var fieldErr *FieldError
+	var mismatch *TypeMismatchError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "Mailer" || !errors.As(err, &mismatch) {
+		t.Errorf("Instance sai kiểu nên trả về FieldError wrap TypeMismatchError, got: %v", err)
+	}
+
+	var missing struct {
+		Mailer *mailer `di:"missing"`
+	}
+	if err := container.Inject(&missing); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Key chưa đăng ký nên trả về ErrNotFound, got: %v", err)
+	}
+
+	var unexported struct {
+		mailer *mailer `di:"mailer"`
+	}
+	if err := container.Inject(&unexported); !errors.Is(err, ErrUnexportedField) {
+		t.Errorf("Field unexported có tag nên trả về ErrUnexportedField, got: %v", err)
+	}
+
+	var lazy struct {
+		Mailer *mailer `di:"mailer,lazy"`
+	}
+	if err := container.Inject(&lazy); !errors.Is(err, ErrInvalidLazyField) {
+		t.Errorf("Field lazy không phải func nên trả về ErrInvalidLazyField, got: %v", err)
+	}
+}
+
+// TestMakeStruct kiểm tra MakeStruct và Inject từ trong factory
+func TestMakeStruct(t *testing.T) {
+	container := New()
+
+	container.Singleton("mailer", func(c Container) interface{} { return &mailer{From: "admin"} })
+	ProvideInstance(container, NewMockService("typed"))
+	container.BindE("notifier", func(c Container) (interface{}, error) {
+		n := &notifier{}
+		return n, c.Inject(n)
+	})
+
+	n, err := MakeStruct[notifier](container)
+	if err != nil || n.Mailer.From != "admin" {
+		t.Fatalf("MakeStruct nên inject các field, got: %v, %v", n, err)
+	}
+	if n.Lazy() != n.Mailer {
+		t.Error("Field lazy nên resolve cùng singleton")
+	}
+
+	injected := container.MustMake("notifier").(*notifier)
+	if injected.Mailer != n.Mailer {
+		t.Error("Inject trong factory nên resolve từ cùng container")
+	}
+
+	if _, err := MakeStruct[string](container); !errors.Is(err, ErrInvalidInjectTarget) {
+		t.Errorf("MakeStruct với kiểu không phải struct nên lỗi, got: %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustMakeStruct nên panic khi Inject lỗi")
+		}
+	}()
+	MustMakeStruct[struct {
+		Missing *mailer `di:"missing"`
+	}](container)
+}
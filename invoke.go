@@ -0,0 +1,188 @@
+package di
+
+import (
+	"context"
+	"reflect"
+)
+
+// applicationKey là key mà Call dùng để inject Application khi Application chưa được đăng ký theo kiểu.
+//
+// Ứng dụng thường tự đăng ký qua `app.Instance("app", app)`.
+const applicationKey = "app"
+
+var (
+	// containerType là reflect.Type của Container interface.
+	containerType = reflect.TypeOf((*Container)(nil)).Elem()
+
+	// applicationType là reflect.Type của Application interface.
+	applicationType = reflect.TypeOf((*Application)(nil)).Elem()
+
+	// contextType là reflect.Type của context.Context interface.
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// Invoke gọi callback như Call và trả về error cuối cùng của callback như lỗi của Invoke.
+//
+//   - Mục đích: Gọi handler dạng `func(...) (T, error)` hoặc `func(...) error` mà không phải
+//     tự kiểm tra phần tử cuối của kết quả.
+//   - Logic: Tham số được resolve như Call. Nếu kết quả cuối của callback có kiểu error,
+//     nó được tách khỏi kết quả và trả về như lỗi của Invoke.
+//   - Tham số: như Call.
+//   - Trả về:
+//   - []interface{}: kết quả của callback, không gồm error cuối.
+//   - error: lỗi của Call, hoặc error do callback trả về.
+func (c *container) Invoke(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	return c.invoke(newResolveState(context.Background()), callback, additionalParams)
+}
+
+// Invoke gọi callback như Invoke, các tham số được resolve trong chuỗi resolve hiện tại.
+func (r *resolver) Invoke(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	return r.invoke(r.state, callback, additionalParams)
+}
+
+// invoke là hiện thực nội bộ của Invoke.
+func (c *container) invoke(state resolveState, callback interface{}, additionalParams []interface{}) ([]interface{}, error) {
+	results, err := c.call(state, callback, additionalParams)
+	if err != nil {
+		return nil, err
+	}
+	return splitError(planFor(reflect.TypeOf(callback)), results)
+}
+
+// splitError tách error cuối cùng khỏi results nếu callback của plan trả về error ở vị trí cuối.
+func splitError(plan *callPlan, results []interface{}) ([]interface{}, error) {
+	if !plan.returnsError {
+		return results, nil
+	}
+
+	last := len(results) - 1
+	if results[last] != nil {
+		return results[:last], results[last].(error)
+	}
+	return results[:last], nil
+}
+
+// callArgs nối các đối số cho callback vào args theo plan và các key đã chọn sẵn abstracts (có thể nil).
+func (c *container) callArgs(state resolveState, plan *callPlan, abstracts []string, args []reflect.Value, additionalParams []interface{}) ([]reflect.Value, error) {
+	// used đánh dấu các additionalParams đã được gán cho tham số cố định, để không lặp lại trong tham số variadic
+	var usedBuf [8]bool
+	var used []bool
+	if len(additionalParams) <= len(usedBuf) {
+		used = usedBuf[:len(additionalParams)]
+	} else {
+		used = make([]bool, len(additionalParams))
+	}
+
+	for i := range plan.params {
+		param := &plan.params[i]
+		var abstract string
+		if abstracts != nil {
+			abstract = abstracts[i]
+		}
+		arg, err := c.callArg(state, param, abstract, additionalParams, used)
+		if err != nil {
+			return nil, &ParameterError{Index: i, Type: param.typ, Cause: err}
+		}
+		args = append(args, arg)
+	}
+
+	if plan.variadic != nil {
+		variadic, err := c.variadicArgs(state, plan.variadic, additionalParams, used)
+		if err != nil {
+			return nil, &ParameterError{Index: len(plan.params), Type: plan.variadic.typ, Cause: err}
+		}
+		args = append(args, variadic...)
+	}
+
+	return args, nil
+}
+
+// callArg tìm đối số cho một tham số cố định: additionalParams, container/context hiện tại, rồi tới container.
+//
+// abstract là key gốc đã chọn sẵn khi Compile, instance đã cache của nó được dùng trực tiếp (cachedValue);
+// "" nghĩa là chọn theo các binding hiện tại (paramAbstract).
+func (c *container) callArg(state resolveState, param *paramPlan, abstract string, additionalParams []interface{}, used []bool) (reflect.Value, error) {
+	for i, additional := range additionalParams {
+		paramValue := reflect.ValueOf(additional)
+		if paramValue.IsValid() && paramValue.Type().AssignableTo(param.typ) {
+			used[i] = true
+			return paramValue, nil
+		}
+	}
+
+	switch param.kind {
+	case paramContainer:
+		return reflect.ValueOf(c.view(state)), nil
+	case paramContext:
+		return reflect.ValueOf(state.ctx), nil
+	}
+
+	if abstract == "" {
+		abstract = c.paramAbstract(param)
+	} else if value, cached := c.cachedValue(state, abstract, param.typ); cached {
+		return value, nil
+	}
+	return c.resolveValue(state, abstract, param.typ)
+}
+
+// paramAbstract chọn key resolve của tham số theo các binding hiện tại:
+// TypeKey của kiểu trước rồi tới tên kiểu ngắn, với Application là key "app" khi kiểu chưa được đăng ký.
+func (c *container) paramAbstract(param *paramPlan) string {
+	abstract := c.qualifiedAbstract(param.key, param.shortKey)
+	if param.kind == paramApplication && !c.Bound(abstract) {
+		abstract = applicationKey
+	}
+	return abstract
+}
+
+// variadicArgs tìm các phần tử cho tham số variadic.
+//
+// Các additionalParams chưa dùng khớp kiểu phần tử (hoặc khớp cả slice) được dùng theo thứ tự;
+// nếu không có, slice được resolve từ container khi kiểu slice đã được đăng ký, nếu không tham số variadic rỗng.
+func (c *container) variadicArgs(state resolveState, param *paramPlan, additionalParams []interface{}, used []bool) ([]reflect.Value, error) {
+	sliceType := param.typ
+	elemType := sliceType.Elem()
+
+	var values []reflect.Value
+	for i, additional := range additionalParams {
+		paramValue := reflect.ValueOf(additional)
+		switch {
+		case used[i] || !paramValue.IsValid():
+		case paramValue.Type().AssignableTo(elemType):
+			values = append(values, paramValue)
+		case paramValue.Type().AssignableTo(sliceType):
+			values = appendElements(values, paramValue)
+		}
+	}
+	if len(values) > 0 {
+		return values, nil
+	}
+
+	abstract := c.qualifiedAbstract(param.key, param.shortKey)
+	if !c.Bound(abstract) {
+		return nil, nil
+	}
+
+	slice, err := c.resolveValue(state, abstract, sliceType)
+	if err != nil {
+		return nil, err
+	}
+	return appendElements(nil, slice), nil
+}
+
+// appendElements nối các phần tử của slice vào values.
+func appendElements(values []reflect.Value, slice reflect.Value) []reflect.Value {
+	for i := 0; i < slice.Len(); i++ {
+		values = append(values, slice.Index(i))
+	}
+	return values
+}
+
+// view trả về Container đại diện cho lượt resolve state: chính c với lời gọi cấp cao nhất ngoài scope,
+// hoặc một resolver giữ chuỗi resolve và scope hiện tại.
+func (c *container) view(state resolveState) Container {
+	if len(state.chain) == 0 && state.scope == nil {
+		return c
+	}
+	return &resolver{container: c, state: state}
+}
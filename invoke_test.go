@@ -0,0 +1,122 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestCallVariadic kiểm tra Call với callback variadic
+func TestCallVariadic(t *testing.T) {
+	container := New()
+
+	join := func(sep string, parts ...string) string { return strings.Join(parts, sep) }
+
+	results, err := container.Call(join, ",", "a", "b")
+	if err != nil {
+		t.Fatalf("Call variadic failed: %v", err)
+	}
+	// Tham số cố định nhận additionalParams đầu tiên khớp kiểu, tham số variadic nhận phần còn lại
+	if results[0] != "a,b" {
+		t.Errorf("Tham số variadic nên nhận các additionalParams còn lại, got: %q", results[0])
+	}
+
+	results, err = container.Call(join, "-", []string{"x", "y"})
+	if err != nil || results[0] != "x-y" {
+		t.Errorf("Slice trong additionalParams nên được trải vào tham số variadic, got: %v, %v", results, err)
+	}
+
+	results, err = container.Call(join, "-")
+	if err != nil || results[0] != "" {
+		t.Errorf("Tham số variadic không có giá trị nên rỗng, got: %v, %v", results, err)
+	}
+
+	// Slice đã đăng ký theo kiểu được resolve cho tham số variadic
+	ProvideInstance(container, []*MockService{NewMockService("a"), NewMockService("b")})
+	results, err = container.Call(func(services ...*MockService) int { return len(services) })
+	if err != nil || results[0] != 2 {
+		t.Errorf("Tham số variadic nên được resolve từ slice đã đăng ký, got: %v, %v", results, err)
+	}
+}
+
+// TestInvoke kiểm tra Invoke tách error cuối cùng của callback
+func TestInvoke(t *testing.T) {
+	container := New()
+	container.Instance("string", "value")
+
+	errFailed := errors.New("failed")
+
+	results, err := container.Invoke(func(s string) (string, error) { return s, nil })
+	if err != nil || len(results) != 1 || results[0] != "value" {
+		t.Errorf("Invoke nên trả về kết quả không gồm error, got: %v, %v", results, err)
+	}
+
+	results, err = container.Invoke(func(s string) (string, error) { return "", errFailed })
+	if !errors.Is(err, errFailed) || len(results) != 1 {
+		t.Errorf("Invoke nên trả về error của callback, got: %v, %v", results, err)
+	}
+
+	if _, err := container.Invoke(func() error { return errFailed }); !errors.Is(err, errFailed) {
+		t.Errorf("Invoke nên trả về error của callback func() error, got: %v", err)
+	}
+
+	// Call giữ nguyên error trong kết quả
+	results, err = container.Call(func() error { return errFailed })
+	if err != nil || results[0] != errFailed {
+		t.Errorf("Call nên trả về error của callback trong kết quả, got: %v, %v", results, err)
+	}
+
+	var paramErr *ParameterError
+	if _, err := container.Invoke(func(m *MockService) error { return nil }); !errors.As(err, &paramErr) {
+		t.Errorf("Invoke nên trả về lỗi resolve tham số, got: %v", err)
+	}
+}
+
+// TestCallSelfInjection kiểm tra inject Container, context.Context và Application
+func TestCallSelfInjection(t *testing.T) {
+	container := New()
+
+	results, err := container.Call(func(c Container) Container { return c })
+	if err != nil || results[0] != container {
+		t.Errorf("Tham số Container nên nhận chính container, got: %v, %v", results, err)
+	}
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+	results, err = container.CallContext(ctx, func(ctx context.Context) interface{} { return ctx.Value(ctxKey{}) })
+	if err != nil || results[0] != "request" {
+		t.Errorf("Tham số context.Context nên nhận ctx của CallContext, got: %v, %v", results, err)
+	}
+
+	// Container nhận được trong factory giữ chuỗi resolve hiện tại
+	container.BindE("loop", func(c Container) (interface{}, error) {
+		results, err := c.Invoke(func(inner Container) (interface{}, error) { return inner.Make("loop") })
+		if err != nil {
+			return nil, err
+		}
+		return results[0], nil
+	})
+	if _, err := container.Make("loop"); !errors.Is(err, ErrCircularDependency) {
+		t.Errorf("Container inject trong factory nên giữ chuỗi resolve, got: %v", err)
+	}
+
+	// Container inject trong scope resolve được binding scoped
+	container.Scoped("request", func(c Container) interface{} { return NewMockService("request") })
+	scope := container.NewScope()
+	results, err = scope.Invoke(func(c Container) (interface{}, error) { return c.Make("request") })
+	if err != nil || results[0] != scope.MustMake("request") {
+		t.Errorf("Container inject trong scope nên resolve binding scoped, got: %v, %v", results, err)
+	}
+
+	// Application được resolve từ key "app"
+	if _, err := container.Call(func(app Application) {}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Application chưa đăng ký nên trả về ErrNotFound, got: %v", err)
+	}
+	app := &mockApp{container: container}
+	container.Instance("app", app)
+	results, err = container.Call(func(app Application) Application { return app })
+	if err != nil || results[0] != app {
+		t.Errorf("Tham số Application nên được resolve từ key app, got: %v, %v", results, err)
+	}
+}
@@ -3,6 +3,8 @@
 package di_mocks
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	di "go.fork.vn/di"
 )
@@ -20,6 +22,73 @@ func (_m *MockContainer) EXPECT() *MockContainer_Expecter {
 	return &MockContainer_Expecter{mock: &_m.Mock}
 }
 
+// AfterResolving provides a mock function with given fields: abstract, fn
+func (_m *MockContainer) AfterResolving(abstract string, fn di.ResolvingFunc) {
+	_m.Called(abstract, fn)
+}
+
+// MockContainer_AfterResolving_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterResolving'
+type MockContainer_AfterResolving_Call struct {
+	*mock.Call
+}
+
+// AfterResolving is a helper method to define mock.On call
+//   - abstract string
+//   - fn di.ResolvingFunc
+func (_e *MockContainer_Expecter) AfterResolving(abstract interface{}, fn interface{}) *MockContainer_AfterResolving_Call {
+	return &MockContainer_AfterResolving_Call{Call: _e.mock.On("AfterResolving", abstract, fn)}
+}
+
+func (_c *MockContainer_AfterResolving_Call) Run(run func(abstract string, fn di.ResolvingFunc)) *MockContainer_AfterResolving_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.ResolvingFunc))
+	})
+	return _c
+}
+
+func (_c *MockContainer_AfterResolving_Call) Return() *MockContainer_AfterResolving_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_AfterResolving_Call) RunAndReturn(run func(string, di.ResolvingFunc)) *MockContainer_AfterResolving_Call {
+	_c.Run(run)
+	return _c
+}
+
+// AfterResolvingAny provides a mock function with given fields: fn
+func (_m *MockContainer) AfterResolvingAny(fn di.ResolvingFunc) {
+	_m.Called(fn)
+}
+
+// MockContainer_AfterResolvingAny_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterResolvingAny'
+type MockContainer_AfterResolvingAny_Call struct {
+	*mock.Call
+}
+
+// AfterResolvingAny is a helper method to define mock.On call
+//   - fn di.ResolvingFunc
+func (_e *MockContainer_Expecter) AfterResolvingAny(fn interface{}) *MockContainer_AfterResolvingAny_Call {
+	return &MockContainer_AfterResolvingAny_Call{Call: _e.mock.On("AfterResolvingAny", fn)}
+}
+
+func (_c *MockContainer_AfterResolvingAny_Call) Run(run func(fn di.ResolvingFunc)) *MockContainer_AfterResolvingAny_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(di.ResolvingFunc))
+	})
+	return _c
+}
+
+func (_c *MockContainer_AfterResolvingAny_Call) Return() *MockContainer_AfterResolvingAny_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_AfterResolvingAny_Call) RunAndReturn(run func(di.ResolvingFunc)) *MockContainer_AfterResolvingAny_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Alias provides a mock function with given fields: abstract, alias
 func (_m *MockContainer) Alias(abstract string, alias string) {
 	_m.Called(abstract, alias)
@@ -88,6 +157,135 @@ func (_c *MockContainer_Bind_Call) RunAndReturn(run func(string, di.BindingFunc)
 	return _c
 }
 
+// BindConstructor provides a mock function with given fields: constructor, keys
+func (_m *MockContainer) BindConstructor(constructor interface{}, keys ...string) error {
+	_va := make([]interface{}, len(keys))
+	for _i := range keys {
+		_va[_i] = keys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, constructor)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindConstructor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...string) error); ok {
+		r0 = rf(constructor, keys...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContainer_BindConstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindConstructor'
+type MockContainer_BindConstructor_Call struct {
+	*mock.Call
+}
+
+// BindConstructor is a helper method to define mock.On call
+//   - constructor interface{}
+//   - keys ...string
+func (_e *MockContainer_Expecter) BindConstructor(constructor interface{}, keys ...interface{}) *MockContainer_BindConstructor_Call {
+	return &MockContainer_BindConstructor_Call{Call: _e.mock.On("BindConstructor",
+		append([]interface{}{constructor}, keys...)...)}
+}
+
+func (_c *MockContainer_BindConstructor_Call) Run(run func(constructor interface{}, keys ...string)) *MockContainer_BindConstructor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_BindConstructor_Call) Return(_a0 error) *MockContainer_BindConstructor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_BindConstructor_Call) RunAndReturn(run func(interface{}, ...string) error) *MockContainer_BindConstructor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BindContext provides a mock function with given fields: abstract, concrete
+func (_m *MockContainer) BindContext(abstract string, concrete di.BindingFuncContext) {
+	_m.Called(abstract, concrete)
+}
+
+// MockContainer_BindContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindContext'
+type MockContainer_BindContext_Call struct {
+	*mock.Call
+}
+
+// BindContext is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFuncContext
+func (_e *MockContainer_Expecter) BindContext(abstract interface{}, concrete interface{}) *MockContainer_BindContext_Call {
+	return &MockContainer_BindContext_Call{Call: _e.mock.On("BindContext", abstract, concrete)}
+}
+
+func (_c *MockContainer_BindContext_Call) Run(run func(abstract string, concrete di.BindingFuncContext)) *MockContainer_BindContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.BindingFuncContext))
+	})
+	return _c
+}
+
+func (_c *MockContainer_BindContext_Call) Return() *MockContainer_BindContext_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_BindContext_Call) RunAndReturn(run func(string, di.BindingFuncContext)) *MockContainer_BindContext_Call {
+	_c.Run(run)
+	return _c
+}
+
+// BindE provides a mock function with given fields: abstract, concrete
+func (_m *MockContainer) BindE(abstract string, concrete di.BindingFuncE) {
+	_m.Called(abstract, concrete)
+}
+
+// MockContainer_BindE_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindE'
+type MockContainer_BindE_Call struct {
+	*mock.Call
+}
+
+// BindE is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFuncE
+func (_e *MockContainer_Expecter) BindE(abstract interface{}, concrete interface{}) *MockContainer_BindE_Call {
+	return &MockContainer_BindE_Call{Call: _e.mock.On("BindE", abstract, concrete)}
+}
+
+func (_c *MockContainer_BindE_Call) Run(run func(abstract string, concrete di.BindingFuncE)) *MockContainer_BindE_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.BindingFuncE))
+	})
+	return _c
+}
+
+func (_c *MockContainer_BindE_Call) Return() *MockContainer_BindE_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_BindE_Call) RunAndReturn(run func(string, di.BindingFuncE)) *MockContainer_BindE_Call {
+	_c.Run(run)
+	return _c
+}
+
 // BindIf provides a mock function with given fields: abstract, concrete
 func (_m *MockContainer) BindIf(abstract string, concrete di.BindingFunc) bool {
 	ret := _m.Called(abstract, concrete)
@@ -135,6 +333,87 @@ func (_c *MockContainer_BindIf_Call) RunAndReturn(run func(string, di.BindingFun
 	return _c
 }
 
+// BindIfE provides a mock function with given fields: abstract, concrete
+func (_m *MockContainer) BindIfE(abstract string, concrete di.BindingFuncE) bool {
+	ret := _m.Called(abstract, concrete)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindIfE")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, di.BindingFuncE) bool); ok {
+		r0 = rf(abstract, concrete)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContainer_BindIfE_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindIfE'
+type MockContainer_BindIfE_Call struct {
+	*mock.Call
+}
+
+// BindIfE is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFuncE
+func (_e *MockContainer_Expecter) BindIfE(abstract interface{}, concrete interface{}) *MockContainer_BindIfE_Call {
+	return &MockContainer_BindIfE_Call{Call: _e.mock.On("BindIfE", abstract, concrete)}
+}
+
+func (_c *MockContainer_BindIfE_Call) Run(run func(abstract string, concrete di.BindingFuncE)) *MockContainer_BindIfE_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.BindingFuncE))
+	})
+	return _c
+}
+
+func (_c *MockContainer_BindIfE_Call) Return(_a0 bool) *MockContainer_BindIfE_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_BindIfE_Call) RunAndReturn(run func(string, di.BindingFuncE) bool) *MockContainer_BindIfE_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BindWith provides a mock function with given fields: abstract, concrete
+func (_m *MockContainer) BindWith(abstract string, concrete di.BindingFuncWith) {
+	_m.Called(abstract, concrete)
+}
+
+// MockContainer_BindWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindWith'
+type MockContainer_BindWith_Call struct {
+	*mock.Call
+}
+
+// BindWith is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFuncWith
+func (_e *MockContainer_Expecter) BindWith(abstract interface{}, concrete interface{}) *MockContainer_BindWith_Call {
+	return &MockContainer_BindWith_Call{Call: _e.mock.On("BindWith", abstract, concrete)}
+}
+
+func (_c *MockContainer_BindWith_Call) Run(run func(abstract string, concrete di.BindingFuncWith)) *MockContainer_BindWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.BindingFuncWith))
+	})
+	return _c
+}
+
+func (_c *MockContainer_BindWith_Call) Return() *MockContainer_BindWith_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_BindWith_Call) RunAndReturn(run func(string, di.BindingFuncWith)) *MockContainer_BindWith_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Bound provides a mock function with given fields: abstract
 func (_m *MockContainer) Bound(abstract string) bool {
 	ret := _m.Called(abstract)
@@ -250,63 +529,33 @@ func (_c *MockContainer_Call_Call) RunAndReturn(run func(interface{}, ...interfa
 	return _c
 }
 
-// Instance provides a mock function with given fields: abstract, instance
-func (_m *MockContainer) Instance(abstract string, instance interface{}) {
-	_m.Called(abstract, instance)
-}
-
-// MockContainer_Instance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Instance'
-type MockContainer_Instance_Call struct {
-	*mock.Call
-}
-
-// Instance is a helper method to define mock.On call
-//   - abstract string
-//   - instance interface{}
-func (_e *MockContainer_Expecter) Instance(abstract interface{}, instance interface{}) *MockContainer_Instance_Call {
-	return &MockContainer_Instance_Call{Call: _e.mock.On("Instance", abstract, instance)}
-}
-
-func (_c *MockContainer_Instance_Call) Run(run func(abstract string, instance interface{})) *MockContainer_Instance_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}))
-	})
-	return _c
-}
-
-func (_c *MockContainer_Instance_Call) Return() *MockContainer_Instance_Call {
-	_c.Call.Return()
-	return _c
-}
-
-func (_c *MockContainer_Instance_Call) RunAndReturn(run func(string, interface{})) *MockContainer_Instance_Call {
-	_c.Run(run)
-	return _c
-}
-
-// Make provides a mock function with given fields: abstract
-func (_m *MockContainer) Make(abstract string) (interface{}, error) {
-	ret := _m.Called(abstract)
+// CallContext provides a mock function with given fields: ctx, callback, additionalParams
+func (_m *MockContainer) CallContext(ctx context.Context, callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, callback)
+	_ca = append(_ca, additionalParams...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Make")
+		panic("no return value specified for CallContext")
 	}
 
-	var r0 interface{}
+	var r0 []interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(abstract)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, ...interface{}) ([]interface{}, error)); ok {
+		return rf(ctx, callback, additionalParams...)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(abstract)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, ...interface{}) []interface{}); ok {
+		r0 = rf(ctx, callback, additionalParams...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).([]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(abstract)
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, ...interface{}) error); ok {
+		r1 = rf(ctx, callback, additionalParams...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -314,55 +563,717 @@ func (_m *MockContainer) Make(abstract string) (interface{}, error) {
 	return r0, r1
 }
 
-// MockContainer_Make_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Make'
-type MockContainer_Make_Call struct {
+// MockContainer_CallContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallContext'
+type MockContainer_CallContext_Call struct {
 	*mock.Call
 }
 
-// Make is a helper method to define mock.On call
-//   - abstract string
-func (_e *MockContainer_Expecter) Make(abstract interface{}) *MockContainer_Make_Call {
-	return &MockContainer_Make_Call{Call: _e.mock.On("Make", abstract)}
+// CallContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - callback interface{}
+//   - additionalParams ...interface{}
+func (_e *MockContainer_Expecter) CallContext(ctx interface{}, callback interface{}, additionalParams ...interface{}) *MockContainer_CallContext_Call {
+	return &MockContainer_CallContext_Call{Call: _e.mock.On("CallContext",
+		append([]interface{}{ctx, callback}, additionalParams...)...)}
 }
 
-func (_c *MockContainer_Make_Call) Run(run func(abstract string)) *MockContainer_Make_Call {
+func (_c *MockContainer_CallContext_Call) Run(run func(ctx context.Context, callback interface{}, additionalParams ...interface{})) *MockContainer_CallContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(interface{}), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockContainer_Make_Call) Return(_a0 interface{}, _a1 error) *MockContainer_Make_Call {
+func (_c *MockContainer_CallContext_Call) Return(_a0 []interface{}, _a1 error) *MockContainer_CallContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContainer_Make_Call) RunAndReturn(run func(string) (interface{}, error)) *MockContainer_Make_Call {
+func (_c *MockContainer_CallContext_Call) RunAndReturn(run func(context.Context, interface{}, ...interface{}) ([]interface{}, error)) *MockContainer_CallContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MustMake provides a mock function with given fields: abstract
-func (_m *MockContainer) MustMake(abstract string) interface{} {
-	ret := _m.Called(abstract)
+// Close provides a mock function with given fields: ctx
+func (_m *MockContainer) Close(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MustMake")
+		panic("no return value specified for Close")
 	}
 
-	var r0 interface{}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(abstract)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContainer_MustMake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustMake'
+// MockContainer_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockContainer_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockContainer_Expecter) Close(ctx interface{}) *MockContainer_Close_Call {
+	return &MockContainer_Close_Call{Call: _e.mock.On("Close", ctx)}
+}
+
+func (_c *MockContainer_Close_Call) Run(run func(ctx context.Context)) *MockContainer_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Close_Call) Return(_a0 error) *MockContainer_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_Close_Call) RunAndReturn(run func(context.Context) error) *MockContainer_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Compile provides a mock function with given fields: callback
+func (_m *MockContainer) Compile(callback interface{}) (di.Invoker, error) {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Compile")
+	}
+
+	var r0 di.Invoker
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (di.Invoker, error)); ok {
+		return rf(callback)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) di.Invoker); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(di.Invoker)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(callback)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContainer_Compile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Compile'
+type MockContainer_Compile_Call struct {
+	*mock.Call
+}
+
+// Compile is a helper method to define mock.On call
+//   - callback interface{}
+func (_e *MockContainer_Expecter) Compile(callback interface{}) *MockContainer_Compile_Call {
+	return &MockContainer_Compile_Call{Call: _e.mock.On("Compile", callback)}
+}
+
+func (_c *MockContainer_Compile_Call) Run(run func(callback interface{})) *MockContainer_Compile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Compile_Call) Return(_a0 di.Invoker, _a1 error) *MockContainer_Compile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContainer_Compile_Call) RunAndReturn(run func(interface{}) (di.Invoker, error)) *MockContainer_Compile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Describe provides a mock function with no fields
+func (_m *MockContainer) Describe() []di.BindingInfo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Describe")
+	}
+
+	var r0 []di.BindingInfo
+	if rf, ok := ret.Get(0).(func() []di.BindingInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]di.BindingInfo)
+		}
+	}
+
+	return r0
+}
+
+// MockContainer_Describe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Describe'
+type MockContainer_Describe_Call struct {
+	*mock.Call
+}
+
+// Describe is a helper method to define mock.On call
+func (_e *MockContainer_Expecter) Describe() *MockContainer_Describe_Call {
+	return &MockContainer_Describe_Call{Call: _e.mock.On("Describe")}
+}
+
+func (_c *MockContainer_Describe_Call) Run(run func()) *MockContainer_Describe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContainer_Describe_Call) Return(_a0 []di.BindingInfo) *MockContainer_Describe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_Describe_Call) RunAndReturn(run func() []di.BindingInfo) *MockContainer_Describe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Extend provides a mock function with given fields: abstract, fn
+func (_m *MockContainer) Extend(abstract string, fn di.ExtenderFunc) {
+	_m.Called(abstract, fn)
+}
+
+// MockContainer_Extend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Extend'
+type MockContainer_Extend_Call struct {
+	*mock.Call
+}
+
+// Extend is a helper method to define mock.On call
+//   - abstract string
+//   - fn di.ExtenderFunc
+func (_e *MockContainer_Expecter) Extend(abstract interface{}, fn interface{}) *MockContainer_Extend_Call {
+	return &MockContainer_Extend_Call{Call: _e.mock.On("Extend", abstract, fn)}
+}
+
+func (_c *MockContainer_Extend_Call) Run(run func(abstract string, fn di.ExtenderFunc)) *MockContainer_Extend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.ExtenderFunc))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Extend_Call) Return() *MockContainer_Extend_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_Extend_Call) RunAndReturn(run func(string, di.ExtenderFunc)) *MockContainer_Extend_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Forget provides a mock function with given fields: abstract, opts
+func (_m *MockContainer) Forget(abstract string, opts ...di.ForgetOption) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, abstract)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockContainer_Forget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forget'
+type MockContainer_Forget_Call struct {
+	*mock.Call
+}
+
+// Forget is a helper method to define mock.On call
+//   - abstract string
+//   - opts ...di.ForgetOption
+func (_e *MockContainer_Expecter) Forget(abstract interface{}, opts ...interface{}) *MockContainer_Forget_Call {
+	return &MockContainer_Forget_Call{Call: _e.mock.On("Forget",
+		append([]interface{}{abstract}, opts...)...)}
+}
+
+func (_c *MockContainer_Forget_Call) Run(run func(abstract string, opts ...di.ForgetOption)) *MockContainer_Forget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]di.ForgetOption, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(di.ForgetOption)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_Forget_Call) Return() *MockContainer_Forget_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_Forget_Call) RunAndReturn(run func(string, ...di.ForgetOption)) *MockContainer_Forget_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ForgetInstance provides a mock function with given fields: abstract, opts
+func (_m *MockContainer) ForgetInstance(abstract string, opts ...di.ForgetOption) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, abstract)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockContainer_ForgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgetInstance'
+type MockContainer_ForgetInstance_Call struct {
+	*mock.Call
+}
+
+// ForgetInstance is a helper method to define mock.On call
+//   - abstract string
+//   - opts ...di.ForgetOption
+func (_e *MockContainer_Expecter) ForgetInstance(abstract interface{}, opts ...interface{}) *MockContainer_ForgetInstance_Call {
+	return &MockContainer_ForgetInstance_Call{Call: _e.mock.On("ForgetInstance",
+		append([]interface{}{abstract}, opts...)...)}
+}
+
+func (_c *MockContainer_ForgetInstance_Call) Run(run func(abstract string, opts ...di.ForgetOption)) *MockContainer_ForgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]di.ForgetOption, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(di.ForgetOption)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_ForgetInstance_Call) Return() *MockContainer_ForgetInstance_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_ForgetInstance_Call) RunAndReturn(run func(string, ...di.ForgetOption)) *MockContainer_ForgetInstance_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Graph provides a mock function with no fields
+func (_m *MockContainer) Graph() di.DependencyGraph {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Graph")
+	}
+
+	var r0 di.DependencyGraph
+	if rf, ok := ret.Get(0).(func() di.DependencyGraph); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(di.DependencyGraph)
+		}
+	}
+
+	return r0
+}
+
+// MockContainer_Graph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Graph'
+type MockContainer_Graph_Call struct {
+	*mock.Call
+}
+
+// Graph is a helper method to define mock.On call
+func (_e *MockContainer_Expecter) Graph() *MockContainer_Graph_Call {
+	return &MockContainer_Graph_Call{Call: _e.mock.On("Graph")}
+}
+
+func (_c *MockContainer_Graph_Call) Run(run func()) *MockContainer_Graph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContainer_Graph_Call) Return(_a0 di.DependencyGraph) *MockContainer_Graph_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_Graph_Call) RunAndReturn(run func() di.DependencyGraph) *MockContainer_Graph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Inject provides a mock function with given fields: target
+func (_m *MockContainer) Inject(target interface{}) error {
+	ret := _m.Called(target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Inject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(target)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContainer_Inject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Inject'
+type MockContainer_Inject_Call struct {
+	*mock.Call
+}
+
+// Inject is a helper method to define mock.On call
+//   - target interface{}
+func (_e *MockContainer_Expecter) Inject(target interface{}) *MockContainer_Inject_Call {
+	return &MockContainer_Inject_Call{Call: _e.mock.On("Inject", target)}
+}
+
+func (_c *MockContainer_Inject_Call) Run(run func(target interface{})) *MockContainer_Inject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Inject_Call) Return(_a0 error) *MockContainer_Inject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_Inject_Call) RunAndReturn(run func(interface{}) error) *MockContainer_Inject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Instance provides a mock function with given fields: abstract, instance
+func (_m *MockContainer) Instance(abstract string, instance interface{}) {
+	_m.Called(abstract, instance)
+}
+
+// MockContainer_Instance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Instance'
+type MockContainer_Instance_Call struct {
+	*mock.Call
+}
+
+// Instance is a helper method to define mock.On call
+//   - abstract string
+//   - instance interface{}
+func (_e *MockContainer_Expecter) Instance(abstract interface{}, instance interface{}) *MockContainer_Instance_Call {
+	return &MockContainer_Instance_Call{Call: _e.mock.On("Instance", abstract, instance)}
+}
+
+func (_c *MockContainer_Instance_Call) Run(run func(abstract string, instance interface{})) *MockContainer_Instance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Instance_Call) Return() *MockContainer_Instance_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_Instance_Call) RunAndReturn(run func(string, interface{})) *MockContainer_Instance_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Invoke provides a mock function with given fields: callback, additionalParams
+func (_m *MockContainer) Invoke(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, callback)
+	_ca = append(_ca, additionalParams...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invoke")
+	}
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) ([]interface{}, error)); ok {
+		return rf(callback, additionalParams...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) []interface{}); ok {
+		r0 = rf(callback, additionalParams...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, ...interface{}) error); ok {
+		r1 = rf(callback, additionalParams...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContainer_Invoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invoke'
+type MockContainer_Invoke_Call struct {
+	*mock.Call
+}
+
+// Invoke is a helper method to define mock.On call
+//   - callback interface{}
+//   - additionalParams ...interface{}
+func (_e *MockContainer_Expecter) Invoke(callback interface{}, additionalParams ...interface{}) *MockContainer_Invoke_Call {
+	return &MockContainer_Invoke_Call{Call: _e.mock.On("Invoke",
+		append([]interface{}{callback}, additionalParams...)...)}
+}
+
+func (_c *MockContainer_Invoke_Call) Run(run func(callback interface{}, additionalParams ...interface{})) *MockContainer_Invoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_Invoke_Call) Return(_a0 []interface{}, _a1 error) *MockContainer_Invoke_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContainer_Invoke_Call) RunAndReturn(run func(interface{}, ...interface{}) ([]interface{}, error)) *MockContainer_Invoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Make provides a mock function with given fields: abstract
+func (_m *MockContainer) Make(abstract string) (interface{}, error) {
+	ret := _m.Called(abstract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Make")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(abstract)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(abstract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(abstract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContainer_Make_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Make'
+type MockContainer_Make_Call struct {
+	*mock.Call
+}
+
+// Make is a helper method to define mock.On call
+//   - abstract string
+func (_e *MockContainer_Expecter) Make(abstract interface{}) *MockContainer_Make_Call {
+	return &MockContainer_Make_Call{Call: _e.mock.On("Make", abstract)}
+}
+
+func (_c *MockContainer_Make_Call) Run(run func(abstract string)) *MockContainer_Make_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Make_Call) Return(_a0 interface{}, _a1 error) *MockContainer_Make_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContainer_Make_Call) RunAndReturn(run func(string) (interface{}, error)) *MockContainer_Make_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeContext provides a mock function with given fields: ctx, abstract
+func (_m *MockContainer) MakeContext(ctx context.Context, abstract string) (interface{}, error) {
+	ret := _m.Called(ctx, abstract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, abstract)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, abstract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, abstract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContainer_MakeContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeContext'
+type MockContainer_MakeContext_Call struct {
+	*mock.Call
+}
+
+// MakeContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - abstract string
+func (_e *MockContainer_Expecter) MakeContext(ctx interface{}, abstract interface{}) *MockContainer_MakeContext_Call {
+	return &MockContainer_MakeContext_Call{Call: _e.mock.On("MakeContext", ctx, abstract)}
+}
+
+func (_c *MockContainer_MakeContext_Call) Run(run func(ctx context.Context, abstract string)) *MockContainer_MakeContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContainer_MakeContext_Call) Return(_a0 interface{}, _a1 error) *MockContainer_MakeContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContainer_MakeContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *MockContainer_MakeContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeWith provides a mock function with given fields: abstract, params
+func (_m *MockContainer) MakeWith(abstract string, params di.Params) (interface{}, error) {
+	ret := _m.Called(abstract, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeWith")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, di.Params) (interface{}, error)); ok {
+		return rf(abstract, params)
+	}
+	if rf, ok := ret.Get(0).(func(string, di.Params) interface{}); ok {
+		r0 = rf(abstract, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, di.Params) error); ok {
+		r1 = rf(abstract, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContainer_MakeWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeWith'
+type MockContainer_MakeWith_Call struct {
+	*mock.Call
+}
+
+// MakeWith is a helper method to define mock.On call
+//   - abstract string
+//   - params di.Params
+func (_e *MockContainer_Expecter) MakeWith(abstract interface{}, params interface{}) *MockContainer_MakeWith_Call {
+	return &MockContainer_MakeWith_Call{Call: _e.mock.On("MakeWith", abstract, params)}
+}
+
+func (_c *MockContainer_MakeWith_Call) Run(run func(abstract string, params di.Params)) *MockContainer_MakeWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.Params))
+	})
+	return _c
+}
+
+func (_c *MockContainer_MakeWith_Call) Return(_a0 interface{}, _a1 error) *MockContainer_MakeWith_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContainer_MakeWith_Call) RunAndReturn(run func(string, di.Params) (interface{}, error)) *MockContainer_MakeWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MustMake provides a mock function with given fields: abstract
+func (_m *MockContainer) MustMake(abstract string) interface{} {
+	ret := _m.Called(abstract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MustMake")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(abstract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// MockContainer_MustMake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustMake'
 type MockContainer_MustMake_Call struct {
 	*mock.Call
 }
@@ -375,50 +1286,373 @@ func (_e *MockContainer_Expecter) MustMake(abstract interface{}) *MockContainer_
 
 func (_c *MockContainer_MustMake_Call) Run(run func(abstract string)) *MockContainer_MustMake_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContainer_MustMake_Call) Return(_a0 interface{}) *MockContainer_MustMake_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_MustMake_Call) RunAndReturn(run func(string) interface{}) *MockContainer_MustMake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewChild provides a mock function with no fields
+func (_m *MockContainer) NewChild() di.Container {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewChild")
+	}
+
+	var r0 di.Container
+	if rf, ok := ret.Get(0).(func() di.Container); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(di.Container)
+		}
+	}
+
+	return r0
+}
+
+// MockContainer_NewChild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewChild'
+type MockContainer_NewChild_Call struct {
+	*mock.Call
+}
+
+// NewChild is a helper method to define mock.On call
+func (_e *MockContainer_Expecter) NewChild() *MockContainer_NewChild_Call {
+	return &MockContainer_NewChild_Call{Call: _e.mock.On("NewChild")}
+}
+
+func (_c *MockContainer_NewChild_Call) Run(run func()) *MockContainer_NewChild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContainer_NewChild_Call) Return(_a0 di.Container) *MockContainer_NewChild_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_NewChild_Call) RunAndReturn(run func() di.Container) *MockContainer_NewChild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewScope provides a mock function with no fields
+func (_m *MockContainer) NewScope() di.Scope {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewScope")
+	}
+
+	var r0 di.Scope
+	if rf, ok := ret.Get(0).(func() di.Scope); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(di.Scope)
+		}
+	}
+
+	return r0
+}
+
+// MockContainer_NewScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewScope'
+type MockContainer_NewScope_Call struct {
+	*mock.Call
+}
+
+// NewScope is a helper method to define mock.On call
+func (_e *MockContainer_Expecter) NewScope() *MockContainer_NewScope_Call {
+	return &MockContainer_NewScope_Call{Call: _e.mock.On("NewScope")}
+}
+
+func (_c *MockContainer_NewScope_Call) Run(run func()) *MockContainer_NewScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContainer_NewScope_Call) Return(_a0 di.Scope) *MockContainer_NewScope_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_NewScope_Call) RunAndReturn(run func() di.Scope) *MockContainer_NewScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rebinding provides a mock function with given fields: abstract, fn
+func (_m *MockContainer) Rebinding(abstract string, fn di.RebindingFunc) {
+	_m.Called(abstract, fn)
+}
+
+// MockContainer_Rebinding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebinding'
+type MockContainer_Rebinding_Call struct {
+	*mock.Call
+}
+
+// Rebinding is a helper method to define mock.On call
+//   - abstract string
+//   - fn di.RebindingFunc
+func (_e *MockContainer_Expecter) Rebinding(abstract interface{}, fn interface{}) *MockContainer_Rebinding_Call {
+	return &MockContainer_Rebinding_Call{Call: _e.mock.On("Rebinding", abstract, fn)}
+}
+
+func (_c *MockContainer_Rebinding_Call) Run(run func(abstract string, fn di.RebindingFunc)) *MockContainer_Rebinding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.RebindingFunc))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Rebinding_Call) Return() *MockContainer_Rebinding_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_Rebinding_Call) RunAndReturn(run func(string, di.RebindingFunc)) *MockContainer_Rebinding_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RemoveAlias provides a mock function with given fields: alias
+func (_m *MockContainer) RemoveAlias(alias string) {
+	_m.Called(alias)
+}
+
+// MockContainer_RemoveAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAlias'
+type MockContainer_RemoveAlias_Call struct {
+	*mock.Call
+}
+
+// RemoveAlias is a helper method to define mock.On call
+//   - alias string
+func (_e *MockContainer_Expecter) RemoveAlias(alias interface{}) *MockContainer_RemoveAlias_Call {
+	return &MockContainer_RemoveAlias_Call{Call: _e.mock.On("RemoveAlias", alias)}
+}
+
+func (_c *MockContainer_RemoveAlias_Call) Run(run func(alias string)) *MockContainer_RemoveAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContainer_RemoveAlias_Call) Return() *MockContainer_RemoveAlias_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_RemoveAlias_Call) RunAndReturn(run func(string)) *MockContainer_RemoveAlias_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Reset provides a mock function with no fields
+func (_m *MockContainer) Reset() {
+	_m.Called()
+}
+
+// MockContainer_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type MockContainer_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *MockContainer_Expecter) Reset() *MockContainer_Reset_Call {
+	return &MockContainer_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *MockContainer_Reset_Call) Run(run func()) *MockContainer_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContainer_Reset_Call) Return() *MockContainer_Reset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_Reset_Call) RunAndReturn(run func()) *MockContainer_Reset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Resolving provides a mock function with given fields: abstract, fn
+func (_m *MockContainer) Resolving(abstract string, fn di.ResolvingFunc) {
+	_m.Called(abstract, fn)
+}
+
+// MockContainer_Resolving_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolving'
+type MockContainer_Resolving_Call struct {
+	*mock.Call
+}
+
+// Resolving is a helper method to define mock.On call
+//   - abstract string
+//   - fn di.ResolvingFunc
+func (_e *MockContainer_Expecter) Resolving(abstract interface{}, fn interface{}) *MockContainer_Resolving_Call {
+	return &MockContainer_Resolving_Call{Call: _e.mock.On("Resolving", abstract, fn)}
+}
+
+func (_c *MockContainer_Resolving_Call) Run(run func(abstract string, fn di.ResolvingFunc)) *MockContainer_Resolving_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.ResolvingFunc))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Resolving_Call) Return() *MockContainer_Resolving_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_Resolving_Call) RunAndReturn(run func(string, di.ResolvingFunc)) *MockContainer_Resolving_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ResolvingAny provides a mock function with given fields: fn
+func (_m *MockContainer) ResolvingAny(fn di.ResolvingFunc) {
+	_m.Called(fn)
+}
+
+// MockContainer_ResolvingAny_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolvingAny'
+type MockContainer_ResolvingAny_Call struct {
+	*mock.Call
+}
+
+// ResolvingAny is a helper method to define mock.On call
+//   - fn di.ResolvingFunc
+func (_e *MockContainer_Expecter) ResolvingAny(fn interface{}) *MockContainer_ResolvingAny_Call {
+	return &MockContainer_ResolvingAny_Call{Call: _e.mock.On("ResolvingAny", fn)}
+}
+
+func (_c *MockContainer_ResolvingAny_Call) Run(run func(fn di.ResolvingFunc)) *MockContainer_ResolvingAny_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(di.ResolvingFunc))
+	})
+	return _c
+}
+
+func (_c *MockContainer_ResolvingAny_Call) Return() *MockContainer_ResolvingAny_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_ResolvingAny_Call) RunAndReturn(run func(di.ResolvingFunc)) *MockContainer_ResolvingAny_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Scoped provides a mock function with given fields: abstract, concrete
+func (_m *MockContainer) Scoped(abstract string, concrete di.BindingFunc) {
+	_m.Called(abstract, concrete)
+}
+
+// MockContainer_Scoped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Scoped'
+type MockContainer_Scoped_Call struct {
+	*mock.Call
+}
+
+// Scoped is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFunc
+func (_e *MockContainer_Expecter) Scoped(abstract interface{}, concrete interface{}) *MockContainer_Scoped_Call {
+	return &MockContainer_Scoped_Call{Call: _e.mock.On("Scoped", abstract, concrete)}
+}
+
+func (_c *MockContainer_Scoped_Call) Run(run func(abstract string, concrete di.BindingFunc)) *MockContainer_Scoped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.BindingFunc))
 	})
 	return _c
 }
 
-func (_c *MockContainer_MustMake_Call) Return(_a0 interface{}) *MockContainer_MustMake_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContainer_Scoped_Call) Return() *MockContainer_Scoped_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockContainer_MustMake_Call) RunAndReturn(run func(string) interface{}) *MockContainer_MustMake_Call {
-	_c.Call.Return(run)
+func (_c *MockContainer_Scoped_Call) RunAndReturn(run func(string, di.BindingFunc)) *MockContainer_Scoped_Call {
+	_c.Run(run)
 	return _c
 }
 
-// Reset provides a mock function with no fields
-func (_m *MockContainer) Reset() {
-	_m.Called()
+// ScopedConstructor provides a mock function with given fields: constructor, keys
+func (_m *MockContainer) ScopedConstructor(constructor interface{}, keys ...string) error {
+	_va := make([]interface{}, len(keys))
+	for _i := range keys {
+		_va[_i] = keys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, constructor)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScopedConstructor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...string) error); ok {
+		r0 = rf(constructor, keys...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
-// MockContainer_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
-type MockContainer_Reset_Call struct {
+// MockContainer_ScopedConstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScopedConstructor'
+type MockContainer_ScopedConstructor_Call struct {
 	*mock.Call
 }
 
-// Reset is a helper method to define mock.On call
-func (_e *MockContainer_Expecter) Reset() *MockContainer_Reset_Call {
-	return &MockContainer_Reset_Call{Call: _e.mock.On("Reset")}
+// ScopedConstructor is a helper method to define mock.On call
+//   - constructor interface{}
+//   - keys ...string
+func (_e *MockContainer_Expecter) ScopedConstructor(constructor interface{}, keys ...interface{}) *MockContainer_ScopedConstructor_Call {
+	return &MockContainer_ScopedConstructor_Call{Call: _e.mock.On("ScopedConstructor",
+		append([]interface{}{constructor}, keys...)...)}
 }
 
-func (_c *MockContainer_Reset_Call) Run(run func()) *MockContainer_Reset_Call {
+func (_c *MockContainer_ScopedConstructor_Call) Run(run func(constructor interface{}, keys ...string)) *MockContainer_ScopedConstructor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockContainer_Reset_Call) Return() *MockContainer_Reset_Call {
-	_c.Call.Return()
+func (_c *MockContainer_ScopedConstructor_Call) Return(_a0 error) *MockContainer_ScopedConstructor_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContainer_Reset_Call) RunAndReturn(run func()) *MockContainer_Reset_Call {
-	_c.Run(run)
+func (_c *MockContainer_ScopedConstructor_Call) RunAndReturn(run func(interface{}, ...string) error) *MockContainer_ScopedConstructor_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
@@ -456,6 +1690,361 @@ func (_c *MockContainer_Singleton_Call) RunAndReturn(run func(string, di.Binding
 	return _c
 }
 
+// SingletonConstructor provides a mock function with given fields: constructor, keys
+func (_m *MockContainer) SingletonConstructor(constructor interface{}, keys ...string) error {
+	_va := make([]interface{}, len(keys))
+	for _i := range keys {
+		_va[_i] = keys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, constructor)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SingletonConstructor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...string) error); ok {
+		r0 = rf(constructor, keys...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContainer_SingletonConstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SingletonConstructor'
+type MockContainer_SingletonConstructor_Call struct {
+	*mock.Call
+}
+
+// SingletonConstructor is a helper method to define mock.On call
+//   - constructor interface{}
+//   - keys ...string
+func (_e *MockContainer_Expecter) SingletonConstructor(constructor interface{}, keys ...interface{}) *MockContainer_SingletonConstructor_Call {
+	return &MockContainer_SingletonConstructor_Call{Call: _e.mock.On("SingletonConstructor",
+		append([]interface{}{constructor}, keys...)...)}
+}
+
+func (_c *MockContainer_SingletonConstructor_Call) Run(run func(constructor interface{}, keys ...string)) *MockContainer_SingletonConstructor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_SingletonConstructor_Call) Return(_a0 error) *MockContainer_SingletonConstructor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_SingletonConstructor_Call) RunAndReturn(run func(interface{}, ...string) error) *MockContainer_SingletonConstructor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SingletonContext provides a mock function with given fields: abstract, concrete
+func (_m *MockContainer) SingletonContext(abstract string, concrete di.BindingFuncContext) {
+	_m.Called(abstract, concrete)
+}
+
+// MockContainer_SingletonContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SingletonContext'
+type MockContainer_SingletonContext_Call struct {
+	*mock.Call
+}
+
+// SingletonContext is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFuncContext
+func (_e *MockContainer_Expecter) SingletonContext(abstract interface{}, concrete interface{}) *MockContainer_SingletonContext_Call {
+	return &MockContainer_SingletonContext_Call{Call: _e.mock.On("SingletonContext", abstract, concrete)}
+}
+
+func (_c *MockContainer_SingletonContext_Call) Run(run func(abstract string, concrete di.BindingFuncContext)) *MockContainer_SingletonContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.BindingFuncContext))
+	})
+	return _c
+}
+
+func (_c *MockContainer_SingletonContext_Call) Return() *MockContainer_SingletonContext_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_SingletonContext_Call) RunAndReturn(run func(string, di.BindingFuncContext)) *MockContainer_SingletonContext_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SingletonE provides a mock function with given fields: abstract, concrete
+func (_m *MockContainer) SingletonE(abstract string, concrete di.BindingFuncE) {
+	_m.Called(abstract, concrete)
+}
+
+// MockContainer_SingletonE_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SingletonE'
+type MockContainer_SingletonE_Call struct {
+	*mock.Call
+}
+
+// SingletonE is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFuncE
+func (_e *MockContainer_Expecter) SingletonE(abstract interface{}, concrete interface{}) *MockContainer_SingletonE_Call {
+	return &MockContainer_SingletonE_Call{Call: _e.mock.On("SingletonE", abstract, concrete)}
+}
+
+func (_c *MockContainer_SingletonE_Call) Run(run func(abstract string, concrete di.BindingFuncE)) *MockContainer_SingletonE_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(di.BindingFuncE))
+	})
+	return _c
+}
+
+func (_c *MockContainer_SingletonE_Call) Return() *MockContainer_SingletonE_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_SingletonE_Call) RunAndReturn(run func(string, di.BindingFuncE)) *MockContainer_SingletonE_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Tag provides a mock function with given fields: tag, abstracts
+func (_m *MockContainer) Tag(tag string, abstracts ...string) {
+	_va := make([]interface{}, len(abstracts))
+	for _i := range abstracts {
+		_va[_i] = abstracts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, tag)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockContainer_Tag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tag'
+type MockContainer_Tag_Call struct {
+	*mock.Call
+}
+
+// Tag is a helper method to define mock.On call
+//   - tag string
+//   - abstracts ...string
+func (_e *MockContainer_Expecter) Tag(tag interface{}, abstracts ...interface{}) *MockContainer_Tag_Call {
+	return &MockContainer_Tag_Call{Call: _e.mock.On("Tag",
+		append([]interface{}{tag}, abstracts...)...)}
+}
+
+func (_c *MockContainer_Tag_Call) Run(run func(tag string, abstracts ...string)) *MockContainer_Tag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_Tag_Call) Return() *MockContainer_Tag_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContainer_Tag_Call) RunAndReturn(run func(string, ...string)) *MockContainer_Tag_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Tagged provides a mock function with given fields: tag
+func (_m *MockContainer) Tagged(tag string) ([]interface{}, error) {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tagged")
+	}
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]interface{}, error)); ok {
+		return rf(tag)
+	}
+	if rf, ok := ret.Get(0).(func(string) []interface{}); ok {
+		r0 = rf(tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContainer_Tagged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tagged'
+type MockContainer_Tagged_Call struct {
+	*mock.Call
+}
+
+// Tagged is a helper method to define mock.On call
+//   - tag string
+func (_e *MockContainer_Expecter) Tagged(tag interface{}) *MockContainer_Tagged_Call {
+	return &MockContainer_Tagged_Call{Call: _e.mock.On("Tagged", tag)}
+}
+
+func (_c *MockContainer_Tagged_Call) Run(run func(tag string)) *MockContainer_Tagged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContainer_Tagged_Call) Return(_a0 []interface{}, _a1 error) *MockContainer_Tagged_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContainer_Tagged_Call) RunAndReturn(run func(string) ([]interface{}, error)) *MockContainer_Tagged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validate provides a mock function with given fields: opts
+func (_m *MockContainer) Validate(opts ...di.ValidateOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...di.ValidateOption) error); ok {
+		r0 = rf(opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContainer_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type MockContainer_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - opts ...di.ValidateOption
+func (_e *MockContainer_Expecter) Validate(opts ...interface{}) *MockContainer_Validate_Call {
+	return &MockContainer_Validate_Call{Call: _e.mock.On("Validate",
+		append([]interface{}{}, opts...)...)}
+}
+
+func (_c *MockContainer_Validate_Call) Run(run func(opts ...di.ValidateOption)) *MockContainer_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]di.ValidateOption, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(di.ValidateOption)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_Validate_Call) Return(_a0 error) *MockContainer_Validate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_Validate_Call) RunAndReturn(run func(...di.ValidateOption) error) *MockContainer_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// When provides a mock function with given fields: consumers
+func (_m *MockContainer) When(consumers ...string) di.ContextualBindingBuilder {
+	_va := make([]interface{}, len(consumers))
+	for _i := range consumers {
+		_va[_i] = consumers[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for When")
+	}
+
+	var r0 di.ContextualBindingBuilder
+	if rf, ok := ret.Get(0).(func(...string) di.ContextualBindingBuilder); ok {
+		r0 = rf(consumers...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(di.ContextualBindingBuilder)
+		}
+	}
+
+	return r0
+}
+
+// MockContainer_When_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'When'
+type MockContainer_When_Call struct {
+	*mock.Call
+}
+
+// When is a helper method to define mock.On call
+//   - consumers ...string
+func (_e *MockContainer_Expecter) When(consumers ...interface{}) *MockContainer_When_Call {
+	return &MockContainer_When_Call{Call: _e.mock.On("When",
+		append([]interface{}{}, consumers...)...)}
+}
+
+func (_c *MockContainer_When_Call) Run(run func(consumers ...string)) *MockContainer_When_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContainer_When_Call) Return(_a0 di.ContextualBindingBuilder) *MockContainer_When_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContainer_When_Call) RunAndReturn(run func(...string) di.ContextualBindingBuilder) *MockContainer_When_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockContainer creates a new instance of MockContainer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockContainer(t interface {
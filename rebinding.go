@@ -0,0 +1,98 @@
+package di
+
+// RebindingFunc là callback được gọi khi một abstract đã được resolve bị đăng ký lại.
+//
+// c là container nơi abstract được đăng ký lại, newInstance là instance resolve từ đăng ký mới.
+type RebindingFunc func(c Container, newInstance interface{})
+
+// rebindingCallback là một callback đã đăng ký qua Rebinding.
+type rebindingCallback struct {
+	// abstract là key (hoặc alias) mà callback theo dõi.
+	abstract string
+
+	// fn là callback.
+	fn RebindingFunc
+}
+
+// Rebinding đăng ký callback được gọi khi abstract đã được resolve bị đăng ký lại.
+//
+//   - Mục đích: Cho phép consumer sống lâu (ví dụ: router giữ handler) chuyển sang instance mới
+//     khi binding hoặc instance bị thay thế.
+//   - Logic:
+//   - Callback được gọi sau Bind/Singleton/Scoped/BindE/... hoặc Instance trên một key đã từng được resolve
+//     từ container này; key chưa từng được resolve không kích hoạt callback.
+//   - Với Instance, newInstance là instance mới (sau khi áp dụng decorator); với binding,
+//     newInstance là kết quả Make sau khi đăng ký lại. Nếu Make lỗi (ví dụ binding scoped ngoài scope),
+//     callback không được gọi.
+//   - Đăng ký lại một singleton bỏ instance đã cache của singleton cũ. Binding đăng ký trên key đang có instance
+//     (qua Instance) không kích hoạt callback, vì Make vẫn trả về instance đó.
+//   - abstract được so khớp sau khi resolve alias; callback của container cha cũng được gọi khi
+//     container con đăng ký lại key.
+//   - Tham số:
+//   - abstract: string — key hoặc alias cần theo dõi.
+//   - fn: RebindingFunc — callback nhận container và instance mới.
+func (c *container) Rebinding(abstract string, fn RebindingFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rebindings = append(c.rebindings, rebindingCallback{abstract: abstract, fn: fn})
+}
+
+// markResolved đánh dấu abstract (đăng ký trên c) đã được resolve.
+func (c *container) markResolved(abstract string) {
+	c.mu.RLock()
+	resolved := c.resolved[abstract]
+	c.mu.RUnlock()
+
+	if resolved {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resolved[abstract] = true
+}
+
+// rebound resolve abstract từ đăng ký mới và gọi các callback Rebinding.
+func (c *container) rebound(abstract string) {
+	callbacks := c.rebindingsFor(abstract)
+	if len(callbacks) == 0 {
+		return
+	}
+
+	instance, err := c.Make(abstract)
+	if err != nil {
+		return
+	}
+
+	for _, fn := range callbacks {
+		fn(c, instance)
+	}
+}
+
+// fireRebinding gọi các callback Rebinding của abstract với instance mới.
+func (c *container) fireRebinding(abstract string, instance interface{}) {
+	for _, fn := range c.rebindingsFor(abstract) {
+		fn(c, instance)
+	}
+}
+
+// rebindingsFor trả về các callback Rebinding của abstract, từ container gốc xuống container hiện tại.
+func (c *container) rebindingsFor(abstract string) []RebindingFunc {
+	var fns []RebindingFunc
+	if c.parent != nil {
+		fns = c.parent.rebindingsFor(abstract)
+	}
+
+	c.mu.RLock()
+	callbacks := c.rebindings
+	c.mu.RUnlock()
+
+	for _, callback := range callbacks {
+		if callback.abstract == abstract || c.canonical(callback.abstract) == abstract {
+			fns = append(fns, callback.fn)
+		}
+	}
+
+	return fns
+}
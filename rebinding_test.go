@@ -0,0 +1,148 @@
+package di
+
+import (
+	"testing"
+)
+
+// router giữ handler đã resolve, dùng để kiểm tra Rebinding
+type router struct {
+	handler interface{}
+}
+
+// TestRebindingBind kiểm tra callback được gọi khi binding đã resolve bị đăng ký lại
+func TestRebindingBind(t *testing.T) {
+	container := New()
+
+	container.Bind("handler", func(c Container) interface{} { return NewMockService("v1") })
+
+	r := &router{handler: container.MustMake("handler")}
+	calls := 0
+	container.Rebinding("handler", func(c Container, newInstance interface{}) {
+		calls++
+		r.handler = newInstance
+	})
+
+	container.Bind("handler", func(c Container) interface{} { return NewMockService("v2") })
+
+	if calls != 1 || r.handler.(*MockService).ID != "v2" {
+		t.Errorf("Rebinding nên chuyển router sang handler mới, calls: %d, handler: %v", calls, r.handler)
+	}
+
+	// Key chưa từng được resolve không kích hoạt callback
+	container.Rebinding("unused", func(c Container, newInstance interface{}) {
+		t.Error("Key chưa resolve không nên kích hoạt Rebinding")
+	})
+	container.Bind("unused", func(c Container) interface{} { return "v1" })
+	container.Bind("unused", func(c Container) interface{} { return "v2" })
+}
+
+// TestRebindingInstanceAndAlias kiểm tra callback với Instance và alias
+func TestRebindingInstanceAndAlias(t *testing.T) {
+	container := New()
+
+	container.Instance("config", "v1")
+	container.Alias("config", "cfg")
+	container.MustMake("cfg")
+
+	var received []interface{}
+	container.Rebinding("cfg", func(c Container, newInstance interface{}) {
+		received = append(received, newInstance)
+	})
+
+	container.Instance("config", "v2")
+
+	if len(received) != 1 || received[0] != "v2" {
+		t.Errorf("Rebinding qua alias nên nhận instance mới, got: %v", received)
+	}
+	if container.MustMake("config") != "v2" {
+		t.Error("Instance mới nên thay thế instance cũ")
+	}
+}
+
+// TestRebindingShadowedByInstance kiểm tra binding mới bị Instance che không kích hoạt callback
+func TestRebindingShadowedByInstance(t *testing.T) {
+	container := New()
+
+	container.Instance("db", "v3")
+	container.MustMake("db")
+
+	container.Rebinding("db", func(c Container, newInstance interface{}) {
+		t.Errorf("Rebinding không nên được gọi khi Instance vẫn che binding mới, got: %v", newInstance)
+	})
+
+	container.Bind("db", func(c Container) interface{} { return "v4" })
+
+	if current := container.MustMake("db"); current != "v3" {
+		t.Errorf("Instance đã đăng ký nên được ưu tiên hơn binding mới, got: %v", current)
+	}
+}
+
+// TestRebindingSingleton kiểm tra đăng ký lại singleton bỏ instance đã cache
+func TestRebindingSingleton(t *testing.T) {
+	container := New()
+
+	container.Singleton("db", func(c Container) interface{} { return NewMockService("primary") })
+	old := container.MustMake("db")
+
+	var received interface{}
+	container.Rebinding("db", func(c Container, newInstance interface{}) {
+		received = newInstance
+	})
+
+	container.Singleton("db", func(c Container) interface{} { return NewMockService("replica") })
+
+	current := container.MustMake("db")
+	if current == old || current.(*MockService).ID != "replica" {
+		t.Errorf("Đăng ký lại singleton nên bỏ instance đã cache, got: %v", current)
+	}
+	if received != current {
+		t.Error("Rebinding nên nhận singleton mới, chia sẻ với Make")
+	}
+
+	// Binding scoped không resolve được ngoài scope nên callback không được gọi
+	received = nil
+	container.Scoped("db", func(c Container) interface{} { return NewMockService("scoped") })
+	if received != nil {
+		t.Errorf("Rebinding không nên được gọi khi instance mới không resolve được, got: %v", received)
+	}
+}
+
+// TestRebindingChild kiểm tra callback của container cha khi container con đăng ký lại
+func TestRebindingChild(t *testing.T) {
+	parent := New()
+	child := parent.NewChild()
+
+	child.Instance("handler", "child-v1")
+	child.MustMake("handler")
+
+	var received interface{}
+	parent.Rebinding("handler", func(c Container, newInstance interface{}) {
+		received = newInstance
+	})
+
+	child.Instance("handler", "child-v2")
+	if received != "child-v2" {
+		t.Errorf("Callback của container cha nên được gọi khi container con đăng ký lại, got: %v", received)
+	}
+
+	// Resolve từ container con đánh dấu key trên container sở hữu đăng ký
+	parent.Bind("service", func(c Container) interface{} { return "v1" })
+	child.MustMake("service")
+
+	var service interface{}
+	parent.Rebinding("service", func(c Container, newInstance interface{}) {
+		service = newInstance
+	})
+	parent.Bind("service", func(c Container) interface{} { return "v2" })
+	if service != "v2" {
+		t.Errorf("Key resolve qua container con nên kích hoạt Rebinding trên container cha, got: %v", service)
+	}
+
+	parent.Reset()
+	parent.Bind("service", func(c Container) interface{} { return "v3" })
+	parent.MustMake("service")
+	parent.Bind("service", func(c Container) interface{} { return "v4" })
+	if service != "v2" {
+		t.Error("Reset() nên xoá callback Rebinding")
+	}
+}
@@ -0,0 +1,149 @@
+package di
+
+import "context"
+
+// resolveState là trạng thái của một lượt resolve, được truyền xuống các resolve lồng nhau.
+type resolveState struct {
+	// ctx là context của lượt resolve, context.Background() với Make/Call.
+	ctx context.Context
+
+	// chain là chuỗi key đang được resolve, phần tử cuối là key mà factory hiện tại đang tạo.
+	chain []string
+
+	// scope là scope của lượt resolve, nil nếu resolve từ container gốc.
+	scope *scope
+
+	// params là tham số runtime của MakeWith, nil với Make.
+	params Params
+
+	// paramsTarget đánh dấu abstract đang resolve là key được truyền vào MakeWith (không phải dependency lồng nhau).
+	paramsTarget bool
+
+	// validation là cache tạm của Validate, nil nếu lượt resolve không thuộc Validate.
+	validation *validation
+}
+
+// withParams trả về trạng thái cho lượt MakeWith resolve abstract với params.
+func (s resolveState) withParams(params Params) resolveState {
+	s.params = params
+	s.paramsTarget = true
+	return s
+}
+
+// param trả về tham số của MakeWith có tên abstract, chỉ áp dụng cho resolve lồng nhau.
+func (s resolveState) param(abstract string) (interface{}, bool) {
+	if s.paramsTarget || s.params == nil {
+		return nil, false
+	}
+	value, exists := s.params[abstract]
+	return value, exists
+}
+
+// newResolveState tạo trạng thái cho một lượt resolve cấp cao nhất.
+func newResolveState(ctx context.Context) resolveState {
+	return resolveState{ctx: ctx}
+}
+
+// with trả về trạng thái của resolve lồng nhau cho abstract.
+func (s resolveState) with(abstract string) resolveState {
+	s.chain = appendChain(s.chain, abstract)
+	s.paramsTarget = false
+	return s
+}
+
+// resolver là view của container được truyền vào factory trong một lượt resolve.
+//
+// resolver mang theo context và chuỗi key đang được resolve để phát hiện circular dependency và gắn
+// đường đi vào lỗi. Các phương thức đăng ký (Bind, Singleton, Instance, ...) được uỷ quyền
+// trực tiếp cho container gốc qua embedding; chỉ các phương thức resolve được ghi đè.
+//
+// Factory nên dùng Container nhận được qua tham số thay vì container bên ngoài closure,
+// nếu không chuỗi resolve và context sẽ bị mất.
+type resolver struct {
+	*container
+
+	// state là trạng thái của lượt resolve đang gọi factory.
+	state resolveState
+}
+
+// Make resolve dependency và nối key vào chuỗi resolve hiện tại.
+func (r *resolver) Make(abstract string) (interface{}, error) {
+	return r.resolve(r.state, abstract)
+}
+
+// MustMake như Make nhưng panic nếu lỗi.
+//
+// Panic mang *ResolutionError sẽ được lượt resolve bao ngoài recover và trả về như error.
+func (r *resolver) MustMake(abstract string) interface{} {
+	instance, err := r.resolve(r.state, abstract)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// MakeContext resolve dependency với ctx trong chuỗi resolve hiện tại.
+func (r *resolver) MakeContext(ctx context.Context, abstract string) (interface{}, error) {
+	state := r.state
+	state.ctx = ctx
+	if len(state.chain) == 0 {
+		return r.resolveContext(state, abstract)
+	}
+	return r.resolve(state, abstract)
+}
+
+// MakeWith resolve dependency với params trong chuỗi resolve hiện tại; params thay thế params của lượt resolve bao ngoài.
+func (r *resolver) MakeWith(abstract string, params Params) (interface{}, error) {
+	return r.resolve(r.state.withParams(params), abstract)
+}
+
+// Call gọi callback, các tham số được resolve trong chuỗi resolve hiện tại.
+func (r *resolver) Call(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	return r.call(r.state, callback, additionalParams)
+}
+
+// CallContext gọi callback, các tham số được resolve với ctx trong chuỗi resolve hiện tại.
+func (r *resolver) CallContext(ctx context.Context, callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	state := r.state
+	state.ctx = ctx
+	return r.call(state, callback, additionalParams)
+}
+
+// Tagged resolve tất cả abstract mang tag trong chuỗi resolve hiện tại.
+func (r *resolver) Tagged(tag string) ([]interface{}, error) {
+	return r.tagged(r.state, tag)
+}
+
+// contextOf trả về context của lượt resolve mà c đại diện, context.Background() nếu c không phải resolver.
+func contextOf(c Container) context.Context {
+	if r, ok := c.(*resolver); ok {
+		return r.state.ctx
+	}
+	return context.Background()
+}
+
+// paramsOf trả về params của lượt resolve mà c đại diện, nil nếu c không phải resolver.
+func paramsOf(c Container) Params {
+	if r, ok := c.(*resolver); ok {
+		return r.state.params
+	}
+	return nil
+}
+
+// withoutParams trả về view của lượt resolve mà c đại diện nhưng không mang params.
+func withoutParams(c Container) Container {
+	if r, ok := c.(*resolver); ok && r.state.params != nil {
+		view := *r
+		view.state.params = nil
+		return &view
+	}
+	return c
+}
+
+// appendChain trả về chuỗi mới gồm chain và abstract, không dùng chung mảng nền với chain.
+func appendChain(chain []string, abstract string) []string {
+	path := make([]string, len(chain)+1)
+	copy(path, chain)
+	path[len(chain)] = abstract
+	return path
+}
@@ -0,0 +1,142 @@
+package di
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestCircularDependency kiểm tra phát hiện phụ thuộc vòng thay vì tràn stack
+func TestCircularDependency(t *testing.T) {
+	container := New()
+
+	container.Bind("a", func(c Container) interface{} {
+		return c.MustMake("b")
+	})
+	container.Bind("b", func(c Container) interface{} {
+		b, err := c.Make("a")
+		if err != nil {
+			panic(err)
+		}
+		return b
+	})
+
+	_, err := container.Make("a")
+	if err == nil {
+		t.Fatal("Make() nên trả về lỗi khi có circular dependency")
+	}
+
+	if !errors.Is(err, ErrCircularDependency) {
+		t.Errorf("Lỗi nên wrap ErrCircularDependency, got: %v", err)
+	}
+
+	if err.Error() != "circular dependency: a -> b -> a" {
+		t.Errorf("Lỗi không chứa đúng chuỗi resolve: %v", err)
+	}
+
+	var resolutionErr *ResolutionError
+	if !errors.As(err, &resolutionErr) || !reflect.DeepEqual(resolutionErr.Chain, []string{"a", "b", "a"}) {
+		t.Errorf("ResolutionError.Chain không đúng: %+v", resolutionErr)
+	}
+
+	// MustMake ở cấp cao nhất panic với cùng lỗi
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustMake() nên panic khi có circular dependency")
+			}
+		}()
+		container.MustMake("b")
+	}()
+}
+
+// TestCircularDependencyThroughAlias kiểm tra phát hiện vòng khi resolve qua alias
+func TestCircularDependencyThroughAlias(t *testing.T) {
+	container := New()
+
+	container.Bind("self", func(c Container) interface{} {
+		return c.MustMake("me")
+	})
+	container.Alias("self", "me")
+
+	_, err := container.Make("self")
+	if err == nil || err.Error() != "circular dependency: self -> self" {
+		t.Errorf("Make() nên phát hiện vòng qua alias, got: %v", err)
+	}
+}
+
+// TestResolutionChainOnMissingKey kiểm tra lỗi thiếu key lồng nhau chứa chuỗi resolve
+func TestResolutionChainOnMissingKey(t *testing.T) {
+	container := New()
+
+	container.Bind("handler", func(c Container) interface{} {
+		return c.MustMake("service")
+	})
+	container.Bind("service", func(c Container) interface{} {
+		return c.MustMake("repository")
+	})
+
+	_, err := container.Make("handler")
+
+	var resolutionErr *ResolutionError
+	if !errors.As(err, &resolutionErr) {
+		t.Fatalf("Make() nên trả về *ResolutionError, got: %v", err)
+	}
+
+	if resolutionErr.Key != "repository" {
+		t.Errorf("ResolutionError.Key = %s, expected repository", resolutionErr.Key)
+	}
+
+	if err.Error() != "bind not found for: repository (resolving handler -> service -> repository)" {
+		t.Errorf("Lỗi không chứa chuỗi resolve: %v", err)
+	}
+
+	// Lỗi ở cấp cao nhất giữ nguyên thông điệp cũ
+	_, err = container.Make("unknown")
+	if err == nil || err.Error() != "bind not found for: unknown" {
+		t.Errorf("Make() lỗi cấp cao nhất không đúng: %v", err)
+	}
+}
+
+// TestResolutionChainThroughTypedFactory kiểm tra chuỗi resolve đi qua factory generic và Call
+func TestResolutionChainThroughTypedFactory(t *testing.T) {
+	container := New()
+
+	Provide(container, func(c Container) (*MockDependencyB, error) {
+		a, err := Resolve[*MockDependencyA](c)
+		if err != nil {
+			return nil, err
+		}
+		return &MockDependencyB{DependencyA: a}, nil
+	})
+	Provide(container, func(c Container) (*MockDependencyA, error) {
+		results, err := c.Call(func(b *MockDependencyB) *MockDependencyA { return b.DependencyA })
+		if err != nil {
+			return nil, err
+		}
+		return results[0].(*MockDependencyA), nil
+	})
+	container.Alias(KeyOf[*MockDependencyB](), "*di.MockDependencyB")
+
+	_, err := Resolve[*MockDependencyB](container)
+	if !errors.Is(err, ErrCircularDependency) {
+		t.Errorf("Resolve() nên phát hiện vòng qua Call và factory generic, got: %v", err)
+	}
+}
+
+// TestBuildRepanicsForeignPanic kiểm tra panic không phải lỗi resolve vẫn được truyền ra ngoài
+func TestBuildRepanicsForeignPanic(t *testing.T) {
+	container := New()
+
+	container.Bind("panics", func(c Container) interface{} {
+		panic("boom")
+	})
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Panic của factory nên được giữ nguyên, got: %v", r)
+		}
+	}()
+
+	_, _ = container.Make("panics")
+}
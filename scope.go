@@ -0,0 +1,122 @@
+package di
+
+import (
+	"context"
+	"sync"
+)
+
+// Scope là một resolver con có vòng đời ngắn (một HTTP request, một job).
+//
+// Scope hiện thực Container: các phương thức đăng ký được uỷ quyền cho container gốc,
+// còn các phương thức resolve (Make, MustMake, MakeContext, Call, CallContext) cache
+// các binding đăng ký qua Scoped trong phạm vi scope. Binding transient, singleton và instance
+// hoạt động như trên container gốc.
+type Scope interface {
+	Container
+
+	// End kết thúc scope và giải phóng mọi scoped instance đã cache như Close, bỏ qua lỗi.
+	// Sau khi End, resolve binding scoped trong scope này trả về ErrScopeEnded.
+	End()
+}
+
+// scope là hiện thực của Scope.
+//
+// scope nhúng resolver với state.scope trỏ về chính nó, nên mọi resolve đi qua scope
+// (kể cả resolve lồng nhau trong factory) đều mang scope theo.
+type scope struct {
+	resolver
+
+	// instances chứa các scoped instance đã tạo (hoặc đang tạo) trong scope, theo key.
+	instances map[string]*pendingInstance
+
+	// disposables là các scoped instance cần giải phóng khi scope kết thúc, theo thứ tự tạo.
+	disposables []disposable
+
+	// ended đánh dấu scope đã kết thúc.
+	ended bool
+
+	// mu bảo vệ instances, disposables và ended.
+	mu sync.Mutex
+}
+
+// NewScope tạo một scope mới từ container.
+//
+//   - Mục đích: Chia sẻ một instance của mỗi binding scoped trong phạm vi một request hoặc một job.
+//   - Logic: Scope giữ cache riêng cho binding scoped; các lifetime khác được resolve như trên container gốc.
+//   - Trả về: Scope — resolver con, cần gọi End khi kết thúc.
+func (c *container) NewScope() Scope {
+	s := &scope{instances: make(map[string]*pendingInstance)}
+	s.resolver = resolver{container: c, state: resolveState{ctx: context.Background(), scope: s}}
+	return s
+}
+
+// Scoped đăng ký binding scoped: một instance được chia sẻ trong mỗi scope.
+//
+//   - Mục đích: Lifetime thứ ba bên cạnh transient (Bind) và singleton, dùng cho dependency theo request/job.
+//   - Logic: Instance được tạo lần đầu khi resolve trong một scope, cache trong scope đó, và bị bỏ khi scope End.
+//     Extender và hook Resolving/AfterResolving được áp dụng một lần cho mỗi scope, trước khi instance được cache.
+//     Resolve ngoài scope (hoặc từ factory của singleton) trả về lỗi wrap ErrScopeRequired.
+//   - Tham số:
+//   - abstract: string — tên logic của dependency.
+//   - concrete: BindingFunc — factory function tạo instance.
+func (c *container) Scoped(abstract string, concrete BindingFunc) {
+	abstract = c.canonical(abstract)
+	c.register(abstract, func(container Container) interface{} {
+		r, ok := container.(*resolver)
+		if !ok || r.state.scope == nil {
+			return &bindingFailure{err: ErrScopeRequired}
+		}
+		return r.state.scope.shared(abstract, func() interface{} {
+			view := withoutParams(container)
+			return c.complete(abstract, concrete(view), view)
+		})
+	}, lifetimeScoped)
+}
+
+// End kết thúc scope và giải phóng các scoped instance; dùng Close để nhận lỗi giải phóng.
+func (s *scope) End() {
+	_ = s.Close(context.Background())
+}
+
+// NewScope tạo scope mới từ container gốc; scope không lồng nhau.
+func (s *scope) NewScope() Scope {
+	return s.container.NewScope()
+}
+
+// shared trả về instance của abstract trong scope, gọi concrete đúng một lần cho mỗi key.
+func (s *scope) shared(abstract string, concrete func() interface{}) interface{} {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return &bindingFailure{err: ErrScopeEnded}
+	}
+
+	if pending, exists := s.instances[abstract]; exists {
+		s.mu.Unlock()
+		<-pending.done
+		return pending.result()
+	}
+
+	pending := &pendingInstance{done: make(chan struct{})}
+	s.instances[abstract] = pending
+	s.mu.Unlock()
+
+	pending.fill(abstract, concrete, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if pending.err == nil {
+			if s.instances[abstract] == pending {
+				s.disposables = trackDisposable(s.disposables, abstract, pending.instance, false)
+			}
+			return
+		}
+
+		// Không cache kết quả lỗi: lần resolve sau trong scope sẽ gọi lại factory
+		if s.instances[abstract] == pending {
+			delete(s.instances, abstract)
+		}
+	})
+
+	return pending.result()
+}
@@ -0,0 +1,183 @@
+package di
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// TestScoped kiểm tra scoped instance được chia sẻ trong scope và tách biệt giữa các scope
+func TestScoped(t *testing.T) {
+	container := New()
+
+	calls := 0
+	container.Scoped("request.context", func(c Container) interface{} {
+		calls++
+		return NewMockService(fmt.Sprintf("request-%d", calls))
+	})
+
+	first := container.NewScope()
+	second := container.NewScope()
+
+	a1 := first.MustMake("request.context")
+	a2 := first.MustMake("request.context")
+	b1 := second.MustMake("request.context")
+
+	if a1 != a2 {
+		t.Error("Scoped instance nên được chia sẻ trong cùng scope")
+	}
+	if a1 == b1 {
+		t.Error("Các scope khác nhau nên có instance riêng")
+	}
+	if calls != 2 {
+		t.Errorf("Factory scoped nên được gọi một lần mỗi scope, calls: %d", calls)
+	}
+}
+
+// TestScopedOutsideScope kiểm tra resolve binding scoped ngoài scope trả về lỗi
+func TestScopedOutsideScope(t *testing.T) {
+	container := New()
+
+	container.Scoped("request.user", func(c Container) interface{} {
+		return NewMockService("user")
+	})
+
+	_, err := container.Make("request.user")
+	if !errors.Is(err, ErrScopeRequired) {
+		t.Errorf("Make() ngoài scope nên trả về ErrScopeRequired, got: %v", err)
+	}
+
+	// Singleton không được giữ scoped instance của một scope cụ thể
+	container.Singleton("captive", func(c Container) interface{} {
+		return c.MustMake("request.user")
+	})
+
+	scope := container.NewScope()
+	if _, err := scope.Make("captive"); !errors.Is(err, ErrScopeRequired) {
+		t.Errorf("Singleton phụ thuộc binding scoped nên trả về ErrScopeRequired, got: %v", err)
+	}
+}
+
+// TestScopeNestedResolution kiểm tra scope được truyền qua các resolve lồng nhau
+func TestScopeNestedResolution(t *testing.T) {
+	container := New()
+
+	container.Scoped("unit.of.work", func(c Container) interface{} {
+		return NewMockService("uow")
+	})
+	container.Bind("repository.user", func(c Container) interface{} {
+		return &MockDependencyB{Value: c.MustMake("unit.of.work").(*MockService).ID}
+	})
+	container.Singleton("config", func(c Container) interface{} {
+		return &MockDependencyA{Value: "config"}
+	})
+
+	scope := container.NewScope()
+	defer scope.End()
+
+	uow := scope.MustMake("unit.of.work")
+
+	results, err := scope.Call(func(a *MockDependencyA) string { return a.Value }, scope.MustMake("config"))
+	if err != nil || results[0] != "config" {
+		t.Errorf("Call() trên scope lỗi: %v, %v", results, err)
+	}
+
+	repository := scope.MustMake("repository.user").(*MockDependencyB)
+	if repository.Value != uow.(*MockService).ID {
+		t.Error("Binding transient trong scope nên nhận scoped instance của scope")
+	}
+
+	// Singleton vẫn được chia sẻ với container gốc
+	if scope.MustMake("config") != container.MustMake("config") {
+		t.Error("Singleton nên được chia sẻ giữa scope và container gốc")
+	}
+
+	// Đăng ký qua scope được uỷ quyền cho container gốc
+	scope.Instance("registered.in.scope", "value")
+	if !container.Bound("registered.in.scope") {
+		t.Error("Đăng ký qua scope nên được uỷ quyền cho container gốc")
+	}
+}
+
+// TestScopeEnd kiểm tra End giải phóng scoped instance
+func TestScopeEnd(t *testing.T) {
+	container := New()
+
+	container.Scoped("session", func(c Container) interface{} {
+		return NewMockService("session")
+	})
+
+	current := container.NewScope()
+	current.MustMake("session")
+	current.End()
+
+	impl := current.(*scope)
+	if len(impl.instances) != 0 {
+		t.Error("End() nên giải phóng các scoped instance")
+	}
+
+	if _, err := current.Make("session"); !errors.Is(err, ErrScopeEnded) {
+		t.Errorf("Make() sau End nên trả về ErrScopeEnded, got: %v", err)
+	}
+}
+
+// TestScopedConcurrency kiểm tra factory scoped chỉ được gọi một lần khi resolve đồng thời
+func TestScopedConcurrency(t *testing.T) {
+	container := New()
+
+	var mu sync.Mutex
+	calls := 0
+	container.Scoped("counter", func(c Container) interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return NewMockService("counter")
+	})
+
+	scope := container.NewScope()
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			results[index] = scope.MustMake("counter")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		if result != results[0] {
+			t.Fatal("Các goroutine trong cùng scope nhận instance khác nhau")
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Factory scoped được gọi %d lần, expected 1", calls)
+	}
+}
+
+// TestScopedFailureNotCached kiểm tra factory scoped lỗi không được cache
+func TestScopedFailureNotCached(t *testing.T) {
+	container := New()
+
+	calls := 0
+	ProvideScoped(container, func(c Container) (*MockService, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("not ready")
+		}
+		return NewMockService("ready"), nil
+	})
+
+	scope := container.NewScope()
+	if _, err := Resolve[*MockService](scope); err == nil {
+		t.Fatal("Resolve() nên trả về lỗi của factory")
+	}
+
+	service, err := Resolve[*MockService](scope)
+	if err != nil || service.ID != "ready" {
+		t.Errorf("Factory scoped lỗi không nên được cache: %v, %v", service, err)
+	}
+}
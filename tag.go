@@ -0,0 +1,130 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Tag gắn tag cho một hoặc nhiều abstract.
+//
+//   - Mục đích: Nhóm nhiều implementation cùng vai trò (ví dụ: "report.exporter") để consumer
+//     resolve tất cả cùng lúc, không cần biết từng key.
+//   - Logic: Abstract được thêm vào cuối danh sách của tag theo thứ tự đăng ký; abstract đã có trong tag bị bỏ qua.
+//     Abstract không cần được bind trước khi gắn tag.
+//   - Tham số:
+//   - tag: string — tên tag.
+//   - abstracts: ...string — các key cần gắn tag.
+func (c *container) Tag(tag string, abstracts ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, abstract := range abstracts {
+		if !containsString(c.tags[tag], abstract) {
+			c.tags[tag] = append(c.tags[tag], abstract)
+		}
+	}
+}
+
+// Tagged resolve tất cả abstract mang tag.
+//
+//   - Logic: Các abstract được resolve theo thứ tự đăng ký; với container con, abstract được gắn tag
+//     trên container cha đứng trước. Mọi abstract đều được thử resolve, kể cả khi có abstract lỗi.
+//   - Tham số:
+//   - tag: string — tên tag.
+//   - Trả về:
+//   - []interface{}: các instance theo thứ tự đăng ký (rỗng nếu tag không có abstract nào).
+//   - error: nil nếu mọi abstract resolve thành công; nếu không, errors.Join của các *ResolutionError
+//     và slice instance là nil.
+func (c *container) Tagged(tag string) ([]interface{}, error) {
+	return c.tagged(newResolveState(context.Background()), tag)
+}
+
+// tagged là hiện thực nội bộ của Tagged.
+func (c *container) tagged(state resolveState, tag string) ([]interface{}, error) {
+	abstracts := c.taggedAbstracts(tag)
+	instances := make([]interface{}, 0, len(abstracts))
+
+	var errs []error
+	for _, abstract := range abstracts {
+		instance, err := c.resolve(state, abstract)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		instances = append(instances, instance)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return instances, nil
+}
+
+// taggedAbstracts trả về các abstract mang tag, từ container gốc xuống container hiện tại.
+func (c *container) taggedAbstracts(tag string) []string {
+	var abstracts []string
+	if c.parent != nil {
+		abstracts = c.parent.taggedAbstracts(tag)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, abstract := range c.tags[tag] {
+		if !containsString(abstracts, abstract) {
+			abstracts = append(abstracts, abstract)
+		}
+	}
+
+	return abstracts
+}
+
+// TaggedAs resolve tất cả abstract mang tag và kiểm tra mỗi instance có kiểu T.
+//
+//   - Tham số: như Tagged.
+//   - Trả về: như Tagged; instance nil cho zero value, instance sai kiểu được báo bằng *TypeMismatchError
+//     (Key có dạng "tag[i]") trong lỗi tổng hợp.
+func TaggedAs[T any](c Container, tag string) ([]T, error) {
+	instances, err := c.Tagged(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := make([]T, len(instances))
+	var errs []error
+	for i, instance := range instances {
+		if instance == nil {
+			continue
+		}
+
+		value, ok := instance.(T)
+		if !ok {
+			errs = append(errs, &TypeMismatchError{
+				Key:      fmt.Sprintf("%s[%d]", tag, i),
+				Expected: reflect.TypeOf((*T)(nil)).Elem(),
+				Actual:   reflect.TypeOf(instance),
+			})
+			continue
+		}
+		typed[i] = value
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return typed, nil
+}
+
+// containsString kiểm tra values có chứa value.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package di
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestTagged kiểm tra Tagged resolve các abstract theo thứ tự đăng ký
+func TestTagged(t *testing.T) {
+	container := New()
+
+	container.Bind("exporter.csv", func(c Container) interface{} { return NewMockService("csv") })
+	container.Singleton("exporter.pdf", func(c Container) interface{} { return NewMockService("pdf") })
+	container.Instance("exporter.json", NewMockService("json"))
+
+	container.Tag("exporters", "exporter.pdf", "exporter.csv")
+	container.Tag("exporters", "exporter.json", "exporter.csv")
+
+	exporters, err := container.Tagged("exporters")
+	if err != nil {
+		t.Fatalf("Tagged() lỗi: %v", err)
+	}
+
+	expected := []string{"pdf", "csv", "json"}
+	if len(exporters) != len(expected) {
+		t.Fatalf("Tagged() trả về %d instance, expected %d", len(exporters), len(expected))
+	}
+	for i, id := range expected {
+		if exporters[i].(*MockService).ID != id {
+			t.Errorf("Tagged()[%d] = %s, expected %s", i, exporters[i].(*MockService).ID, id)
+		}
+	}
+
+	if empty, err := container.Tagged("unknown"); err != nil || len(empty) != 0 {
+		t.Errorf("Tagged() với tag không tồn tại nên trả về slice rỗng: %v, %v", empty, err)
+	}
+
+	container.Reset()
+	if after, _ := container.Tagged("exporters"); len(after) != 0 {
+		t.Error("Reset() nên xoá các tag")
+	}
+}
+
+// TestTaggedErrors kiểm tra lỗi của mọi abstract được tổng hợp
+func TestTaggedErrors(t *testing.T) {
+	container := New()
+
+	failure := errors.New("exporter unavailable")
+	container.BindE("exporter.broken", func(c Container) (interface{}, error) { return nil, failure })
+	container.Bind("exporter.csv", func(c Container) interface{} { return NewMockService("csv") })
+	container.Tag("exporters", "exporter.broken", "exporter.csv", "exporter.missing")
+
+	exporters, err := container.Tagged("exporters")
+	if err == nil {
+		t.Fatal("Tagged() nên trả về lỗi khi có abstract không resolve được")
+	}
+	if exporters != nil {
+		t.Error("Tagged() lỗi không nên trả về instance")
+	}
+	if !errors.Is(err, failure) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("Lỗi tổng hợp nên chứa lỗi của mọi abstract, got: %v", err)
+	}
+
+	var resolutionErr *ResolutionError
+	if !errors.As(err, &resolutionErr) || resolutionErr.Key != "exporter.broken" {
+		t.Errorf("Lỗi tổng hợp nên chứa *ResolutionError theo thứ tự đăng ký, got: %v", err)
+	}
+}
+
+// TestTaggedNested kiểm tra Tagged trong factory giữ chuỗi resolve và container con
+func TestTaggedNested(t *testing.T) {
+	parent := New()
+	parent.Instance("handler.auth", "auth")
+	parent.Tag("handlers", "handler.auth")
+	parent.Bind("router", func(c Container) interface{} {
+		handlers, err := c.Tagged("handlers")
+		if err != nil {
+			return &bindingFailure{err: err}
+		}
+		return handlers
+	})
+
+	child := parent.NewChild()
+	child.Instance("handler.auth", "child-auth")
+	child.Instance("handler.debug", "debug")
+	child.Tag("handlers", "handler.debug", "handler.auth")
+
+	handlers := child.MustMake("router").([]interface{})
+	if len(handlers) != 2 || handlers[0] != "child-auth" || handlers[1] != "debug" {
+		t.Errorf("Tagged() trên container con nên gộp tag của container cha trước, got: %v", handlers)
+	}
+	if handlers := parent.MustMake("router").([]interface{}); len(handlers) != 1 || handlers[0] != "auth" {
+		t.Errorf("Tag trên container con không nên ảnh hưởng container cha, got: %v", handlers)
+	}
+
+	parent.Tag("handlers", "router")
+	_, err := parent.Make("router")
+	if !errors.Is(err, ErrCircularDependency) {
+		t.Errorf("Tagged() trong factory nên phát hiện circular dependency, got: %v", err)
+	}
+}
+
+// TestTaggedAs kiểm tra TaggedAs trả về instance có kiểu
+func TestTaggedAs(t *testing.T) {
+	container := New()
+	container.Instance("a", NewMockService("a"))
+	container.Instance("b", NewMockService("b"))
+	container.Tag("services", "a", "b")
+
+	services, err := TaggedAs[*MockService](container, "services")
+	if err != nil || len(services) != 2 || services[1].ID != "b" {
+		t.Errorf("TaggedAs() lỗi: %v, %v", services, err)
+	}
+
+	container.Instance("c", "not a service")
+	container.Tag("services", "c")
+
+	_, err = TaggedAs[*MockService](container, "services")
+	var mismatch *TypeMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Key != "services[2]" {
+		t.Errorf("TaggedAs() nên trả về *TypeMismatchError, got: %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ValidateOption tuỳ chỉnh phạm vi của Validate.
+type ValidateOption func(*validateOptions)
+
+// validateOptions là cấu hình của một lần Validate.
+type validateOptions struct {
+	// singletonsOnly chỉ kiểm tra binding singleton.
+	singletonsOnly bool
+
+	// parameterized kiểm tra cả binding đăng ký qua BindWith.
+	parameterized bool
+}
+
+// SingletonsOnly giới hạn Validate ở các binding singleton, bỏ qua binding transient, scoped và alias.
+func SingletonsOnly() ValidateOption {
+	return func(o *validateOptions) {
+		o.singletonsOnly = true
+	}
+}
+
+// IncludeParameterized kiểm tra cả binding đăng ký qua BindWith (và alias trỏ tới chúng) trong Validate.
+//
+// Factory của chúng nhận Params rỗng, nên chỉ dùng khi factory không bắt buộc tham số.
+func IncludeParameterized() ValidateOption {
+	return func(o *validateOptions) {
+		o.parameterized = true
+	}
+}
+
+// Validate resolve thử mọi binding đã đăng ký để phát hiện wiring hỏng trước khi chúng được dùng.
+//
+//   - Mục đích: Chạy trong unit test hoặc khi khởi động (sau khi đăng ký provider) thay vì chờ một endpoint
+//     hiếm dùng gọi MustMake trên production. Với Application, gọi app.Container().Validate().
+//   - Logic:
+//   - Mọi key trong Describe (trừ instance) được resolve trong một scope riêng, nên binding scoped cũng được kiểm tra.
+//   - Mọi lỗi được thu thập, không dừng ở lỗi đầu tiên: key chưa đăng ký, phụ thuộc vòng, lỗi của factory,
+//     kiểu không khớp và panic của factory (*PanicError).
+//   - Validate cô lập với container: singleton chưa được khởi tạo được tạo trong một cache tạm của lượt Validate
+//     (singleton đã cache được dùng lại), và Validate không ghi nhận cờ resolved (callback Rebinding) hay
+//     đồ thị phụ thuộc. Khi kết thúc, singleton tạm được giải phóng như Close và scoped instance được giải phóng
+//     cùng scope; container (kể cả container cha) không bị thay đổi, nên có thể gọi Validate đồng thời với các
+//     resolve khác.
+//   - Binding đăng ký qua BindWith (và alias trỏ tới chúng) được bỏ qua vì factory của chúng cần Params
+//     của MakeWith; dùng IncludeParameterized để kiểm tra chúng với Params rỗng. Binding khác resolve
+//     binding BindWith lồng nhau vẫn được kiểm tra, với Params rỗng.
+//   - Hook Resolving/AfterResolving không được gọi trong Validate, để chúng không giữ instance tạm.
+//   - Không cô lập được: instance transient đã tạo và tác dụng phụ bên ngoài của factory
+//     (kể cả Instance mà factory đăng ký).
+//   - Tham số:
+//   - opts: ...ValidateOption — SingletonsOnly để chỉ kiểm tra singleton, IncludeParameterized để kiểm tra cả binding BindWith.
+//   - Trả về: error — *ValidationError nếu có key lỗi (kết hợp với lỗi giải phóng, nếu có, bằng errors.Join);
+//     nil nếu mọi key resolve được.
+func (c *container) Validate(opts ...ValidateOption) error {
+	var options validateOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	scope := c.NewScope().(*scope)
+	v := &validation{instances: make(map[validationKey]*pendingInstance)}
+	view := &resolver{container: c, state: resolveState{ctx: context.Background(), scope: scope, validation: v}}
+
+	var failures []ValidationFailure
+	for _, info := range c.Describe() {
+		if info.Kind == KindInstance || (options.singletonsOnly && info.Kind != KindSingleton) {
+			continue
+		}
+		if !options.parameterized && c.isParamFactory(info.Key) {
+			continue
+		}
+		if err := validateKey(view, info.Key); err != nil {
+			failures = append(failures, ValidationFailure{Key: info.Key, Kind: info.Kind, Source: info.Source, Err: err})
+		}
+	}
+
+	errs := []error{scope.Close(context.Background()), v.close()}
+	if len(failures) > 0 {
+		errs = append([]error{&ValidationError{Failures: failures}}, errs...)
+	}
+	return errors.Join(errs...)
+}
+
+// validateKey resolve key qua view của Validate, chuyển panic của factory thành lỗi.
+func validateKey(view Container, key string) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if resolutionErr, ok := recovered.(*ResolutionError); ok {
+				err = resolutionErr
+			} else {
+				err = &PanicError{Key: key, Value: recovered}
+			}
+		}
+	}()
+
+	_, err = view.Make(key)
+	return err
+}
+
+// validationKey là key của một singleton trong cache tạm của Validate: container sở hữu binding và abstract.
+type validationKey struct {
+	owner    *container
+	abstract string
+}
+
+// validation là cache tạm của một lượt Validate, thay cho cache singleton của container.
+type validation struct {
+	// instances chứa các singleton đã tạo (hoặc đang tạo) trong lượt Validate.
+	instances map[validationKey]*pendingInstance
+
+	// disposables là các singleton tạm cần giải phóng khi Validate kết thúc, theo thứ tự tạo.
+	disposables []disposable
+
+	// mu bảo vệ instances và disposables.
+	mu sync.Mutex
+}
+
+// validationOf trả về cache tạm của Validate mà view thuộc về, nil nếu view không resolve trong Validate.
+func validationOf(view Container) *validation {
+	if r, ok := view.(*resolver); ok {
+		return r.state.validation
+	}
+	return nil
+}
+
+// validationSingleton resolve singleton abstract của c trong lượt Validate v: singleton đã cache trên c
+// được dùng lại, nếu không concrete được gọi đúng một lần và kết quả chỉ được cache trong v.
+func (c *container) validationSingleton(v *validation, abstract string, concrete BindingFunc) interface{} {
+	c.mu.RLock()
+	instance, exists := c.instances[abstract]
+	c.mu.RUnlock()
+
+	if exists {
+		return instance
+	}
+
+	key := validationKey{owner: c, abstract: abstract}
+
+	v.mu.Lock()
+	if pending, building := v.instances[key]; building {
+		v.mu.Unlock()
+		<-pending.done
+		return pending.result()
+	}
+
+	pending := &pendingInstance{done: make(chan struct{})}
+	v.instances[key] = pending
+	v.mu.Unlock()
+
+	pending.fill(abstract, func() interface{} {
+		return concrete(c)
+	}, func() {
+		if pending.err != nil {
+			return
+		}
+		v.mu.Lock()
+		v.disposables = trackDisposable(v.disposables, abstract, pending.instance, false)
+		v.mu.Unlock()
+	})
+
+	return pending.result()
+}
+
+// close giải phóng các singleton tạm của lượt Validate theo thứ tự ngược với thứ tự tạo.
+func (v *validation) close() error {
+	v.mu.Lock()
+	disposables := v.disposables
+	v.disposables = nil
+	v.mu.Unlock()
+
+	return disposeAll(context.Background(), disposables, nil)
+}
@@ -0,0 +1,300 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestValidate kiểm tra Validate tổng hợp mọi lỗi wiring thay vì dừng ở lỗi đầu tiên
+func TestValidate(t *testing.T) {
+	container := New()
+
+	container.Instance("config", "dsn")
+	container.Singleton("db", func(c Container) interface{} {
+		return c.MustMake("config")
+	})
+	container.Bind("repo", func(c Container) interface{} {
+		return c.MustMake("db")
+	})
+	container.Bind("mailer", func(c Container) interface{} {
+		return c.MustMake("smtp")
+	})
+	container.Singleton("broken", func(c Container) interface{} {
+		panic("boom")
+	})
+	container.Bind("a", func(c Container) interface{} {
+		return c.MustMake("b")
+	})
+	container.Bind("b", func(c Container) interface{} {
+		return c.MustMake("a")
+	})
+	container.BindE("port", func(c Container) (interface{}, error) {
+		return MakeAs[int](c, "config")
+	})
+	container.Alias("missing", "dangling")
+
+	err := container.Validate()
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %T", err)
+	}
+
+	failed := make(map[string]ValidationFailure)
+	for _, failure := range validationErr.Failures {
+		failed[failure.Key] = failure
+	}
+
+	for _, key := range []string{"config", "db", "repo"} {
+		if _, ok := failed[key]; ok {
+			t.Errorf("Expected %s to pass validation, got %v", key, failed[key].Err)
+		}
+	}
+
+	if failure, ok := failed["mailer"]; !ok || !errors.Is(failure.Err, ErrNotFound) {
+		t.Errorf("Expected mailer to fail with ErrNotFound, got %+v", failure)
+	}
+	if failure, ok := failed["dangling"]; !ok || failure.Kind != KindAlias || !errors.Is(failure.Err, ErrNotFound) {
+		t.Errorf("Expected dangling alias to fail with ErrNotFound, got %+v", failure)
+	}
+
+	var panicErr *PanicError
+	if failure, ok := failed["broken"]; !ok || !errors.As(failure.Err, &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("Expected broken to fail with *PanicError, got %+v", failure)
+	}
+
+	for _, key := range []string{"a", "b"} {
+		if failure, ok := failed[key]; !ok || !errors.Is(failure.Err, ErrCircularDependency) {
+			t.Errorf("Expected %s to fail with ErrCircularDependency, got %+v", key, failure)
+		}
+	}
+
+	var mismatch *TypeMismatchError
+	if failure, ok := failed["port"]; !ok || !errors.As(failure.Err, &mismatch) {
+		t.Errorf("Expected port to fail with *TypeMismatchError, got %+v", failure)
+	}
+
+	if !strings.Contains(err.Error(), "mailer [binding] registered at ") || !strings.Contains(err.Error(), "validate_test.go") {
+		t.Errorf("Expected report to include kind and source, got:\n%s", err)
+	}
+
+	if !errors.Is(err, ErrNotFound) || !errors.Is(err, ErrCircularDependency) {
+		t.Error("Expected errors.Is to match causes of individual failures")
+	}
+}
+
+// TestValidatePasses kiểm tra Validate trả về nil khi mọi binding resolve được
+func TestValidatePasses(t *testing.T) {
+	container := New()
+	container.Instance("config", "dsn")
+	container.Singleton("db", func(c Container) interface{} {
+		return c.MustMake("config")
+	})
+	container.Scoped("request", func(c Container) interface{} {
+		return c.MustMake("db")
+	})
+	container.Alias("db", "database")
+
+	if err := container.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+// TestValidateRollback kiểm tra singleton tạo trong Validate không bị cache và được giải phóng
+func TestValidateRollback(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+
+	calls := 0
+	container.Singleton("db", func(c Container) interface{} {
+		calls++
+		return &closerResource{name: "db", recorder: recorder}
+	})
+	container.Scoped("session", func(c Container) interface{} {
+		c.MustMake("db")
+		return &closerResource{name: "session", recorder: recorder}
+	})
+
+	rebound := 0
+	container.Rebinding("db", func(c Container, instance interface{}) {
+		rebound++
+	})
+
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := strings.Join(recorder.closed, ","); got != "session,db" {
+		t.Errorf("Expected session and db to be disposed in reverse order, got %q", got)
+	}
+
+	for _, info := range container.Describe() {
+		if info.Key == "db" && info.Built {
+			t.Error("Expected db singleton not to be cached after Validate")
+		}
+	}
+	if edges := container.Graph().Edges; len(edges) != 0 {
+		t.Errorf("Expected dependency graph to be restored, got %v", edges)
+	}
+
+	// Singleton được tạo lại sau Validate; rebind không được kích hoạt vì db chưa từng được resolve
+	container.MustMake("db")
+	if calls != 2 {
+		t.Errorf("Expected db factory to run again after Validate, got %d calls", calls)
+	}
+	container.Singleton("db", func(c Container) interface{} {
+		return "replaced"
+	})
+	if rebound != 1 {
+		t.Errorf("Expected 1 rebinding after real resolve, got %d", rebound)
+	}
+}
+
+// TestValidateKeepsExistingSingletons kiểm tra singleton đã cache trước Validate được giữ nguyên
+func TestValidateKeepsExistingSingletons(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+
+	container.Singleton("db", func(c Container) interface{} {
+		return &closerResource{name: "db", recorder: recorder}
+	})
+	db := container.MustMake("db")
+
+	child := container.NewChild()
+	child.Singleton("cache", func(c Container) interface{} {
+		c.MustMake("db")
+		return &closerResource{name: "cache", recorder: recorder}
+	})
+
+	if err := child.Validate(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := strings.Join(recorder.closed, ","); got != "cache" {
+		t.Errorf("Expected only cache to be disposed, got %q", got)
+	}
+	if container.MustMake("db") != db {
+		t.Error("Expected db singleton cached before Validate to be kept")
+	}
+}
+
+// TestValidateIsolated kiểm tra Validate không thay đổi cache singleton của container
+func TestValidateIsolated(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+
+	container.Singleton("db", func(c Container) interface{} {
+		return &closerResource{name: "db", recorder: recorder}
+	})
+	var registry []interface{}
+	container.Resolving("db", func(abstract string, instance interface{}, c Container) {
+		registry = append(registry, instance)
+	})
+
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(registry) != 0 {
+		t.Errorf("Expected Resolving hooks not to see Validate's temporary instances, got %v", registry)
+	}
+
+	db := container.MustMake("db")
+	if len(registry) != 1 || registry[0] != db {
+		t.Errorf("Expected the hook to capture the live singleton, got %v", registry)
+	}
+
+	// Singleton đã cache được dùng lại, không bị giải phóng hay thay thế
+	recorder.closed = nil
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(recorder.closed) != 0 || container.MustMake("db") != db {
+		t.Errorf("Expected cached db to be kept open, got closed %v", recorder.closed)
+	}
+}
+
+// TestValidateKeepsNewInstances kiểm tra instance đăng ký trong lúc Validate không bị bỏ khi khôi phục
+func TestValidateKeepsNewInstances(t *testing.T) {
+	container := New()
+	recorder := &closeRecorder{}
+
+	container.Singleton("db", func(c Container) interface{} {
+		c.Instance("pool", &closerResource{name: "pool", recorder: recorder})
+		return &closerResource{name: "db", recorder: recorder}
+	})
+	container.Singleton("cache", func(c Container) interface{} {
+		return &closerResource{name: "cache", recorder: recorder}
+	})
+	container.MustMake("cache")
+
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := strings.Join(recorder.closed, ","); got != "db" {
+		t.Errorf("Expected only db to be disposed, got %q", got)
+	}
+	if !container.Bound("pool") {
+		t.Fatal("Expected instance registered during Validate to be kept")
+	}
+
+	recorder.closed = nil
+	if err := container.Close(context.Background()); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if got := strings.Join(recorder.closed, ","); got != "pool,cache" {
+		t.Errorf("Expected pool and cache to stay tracked for Close, got %q", got)
+	}
+}
+
+// TestValidateParameterized kiểm tra binding BindWith chỉ được kiểm tra với IncludeParameterized
+func TestValidateParameterized(t *testing.T) {
+	container := New()
+	container.BindWith("client", func(c Container, params Params) (interface{}, error) {
+		if _, ok := params["baseURL"]; !ok {
+			return nil, errors.New("baseURL is required")
+		}
+		return params["baseURL"], nil
+	})
+	container.Alias("client", "http.client")
+
+	if err := container.Validate(); err != nil {
+		t.Errorf("Expected BindWith bindings to be skipped, got %v", err)
+	}
+
+	err := container.Validate(IncludeParameterized())
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+	if len(validationErr.Failures) != 2 {
+		t.Errorf("Expected client and its alias to fail with empty params, got %v", validationErr.Failures)
+	}
+}
+
+// TestValidateSingletonsOnly kiểm tra SingletonsOnly chỉ kiểm tra binding singleton
+func TestValidateSingletonsOnly(t *testing.T) {
+	container := New()
+	container.Singleton("db", func(c Container) interface{} {
+		return c.MustMake("config")
+	})
+	container.Bind("handler", func(c Container) interface{} {
+		return c.MustMake("router")
+	})
+
+	err := container.Validate(SingletonsOnly())
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+	if len(validationErr.Failures) != 1 || validationErr.Failures[0].Key != "db" {
+		t.Errorf("Expected only db to fail, got %+v", validationErr.Failures)
+	}
+}